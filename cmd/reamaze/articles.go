@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+func articlesList(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	query := fs.String("q", "", "search query")
+	status := fs.Int("status", 0, "only articles with this status: 1 draft or 2 internal")
+	page := fs.Int("page", 0, "page number")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetArticlesWithContext(ctx,
+		reamaze.WithArticleQuery(*query),
+		reamaze.WithArticleStatus(reamaze.ReamazeArticleStatus(*status)),
+		reamaze.WithArticlePage(*page),
+	)
+	if err != nil {
+		return err
+	}
+	t := table{header: []string{"SLUG", "TITLE", "STATUS", "TOPIC", "UPDATED"}}
+	for _, article := range resp.Articles {
+		t.rows = append(t.rows, []string{article.Slug, article.Title, strconv.Itoa(article.Status), article.Topic.Slug, formatTime(article.UpdatedAt)})
+	}
+	return a.print(resp, t)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// config keys, used both as environment variables and in the config file
+const (
+	keyEmail    = "REAMAZE_EMAIL"
+	keyAPIToken = "REAMAZE_API_TOKEN"
+	keyBrand    = "REAMAZE_BRAND"
+	keyBaseURL  = "REAMAZE_BASE_URL"
+	keyConfig   = "REAMAZE_CONFIG"
+)
+
+type config struct {
+	Email    string
+	APIToken string
+	Brand    string
+	BaseURL  string
+}
+
+// loadConfig returns config read from environment variables, falling back to the config file for the ones not set.
+//
+// The config file holds KEY=VALUE lines with the same keys as the environment variables, blank lines and lines
+// starting with # are skipped:
+//
+//	REAMAZE_EMAIL=admin@example.com
+//	REAMAZE_API_TOKEN=secret
+//	REAMAZE_BRAND=example
+//
+// The file is read from path, $REAMAZE_CONFIG or reamaze/config in the user config directory, the first one set.
+// Only the default file may be missing.
+func loadConfig(path string, getenv func(string) string) (config, error) {
+	var cfg config
+	if len(path) == 0 {
+		path = getenv(keyConfig)
+	}
+	values, err := readConfigFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	lookup := func(key string) string {
+		if v := getenv(key); len(v) > 0 {
+			return v
+		}
+		return values[key]
+	}
+	cfg.Email = lookup(keyEmail)
+	cfg.APIToken = lookup(keyAPIToken)
+	cfg.Brand = lookup(keyBrand)
+	cfg.BaseURL = lookup(keyBaseURL)
+
+	var missing []string
+	for _, kv := range [][2]string{{keyEmail, cfg.Email}, {keyAPIToken, cfg.APIToken}, {keyBrand, cfg.Brand}} {
+		if len(kv[1]) == 0 {
+			missing = append(missing, kv[0])
+		}
+	}
+	if len(missing) > 0 {
+		return cfg, fmt.Errorf("missing %s, please set them in the environment or the config file", strings.Join(missing, ", "))
+	}
+	return cfg, nil
+}
+
+// readConfigFile returns KEY=VALUE pairs of the config file, the default one when path is empty
+func readConfigFile(path string) (map[string]string, error) {
+	values := map[string]string{}
+	optional := len(path) == 0
+	if optional {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return values, nil
+		}
+		path = filepath.Join(dir, "reamaze", "config")
+	}
+	f, err := os.Open(path)
+	if optional && errors.Is(err, fs.ErrNotExist) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", path, line)
+		}
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return values, scanner.Err()
+}
+
+// newClient returns a client authenticated with cfg credentials
+func (cfg config) newClient() (reamaze.API, error) {
+	opts := []reamaze.ClientOption{reamaze.WithUserAgent("reamaze-cli")}
+	if len(cfg.BaseURL) > 0 {
+		opts = append(opts, reamaze.WithBaseURL(cfg.BaseURL))
+	}
+	client, err := reamaze.NewClient(cfg.Email, cfg.APIToken, cfg.Brand, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
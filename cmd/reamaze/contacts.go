@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+var contactsHeader = []string{"NAME", "EMAIL", "MOBILE", "CREATED"}
+
+func contactRow(c *reamaze.GetContactResponse) []string {
+	return []string{c.Name, c.Email, c.Mobile, formatTime(c.CreatedAt)}
+}
+
+func contactsList(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	query := fs.String("q", "", "search query")
+	identifierType := fs.String("type", "", "only contacts with identity of this type: email, mobile, facebook, twitter or instagram")
+	page := fs.Int("page", 0, "page number")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetContactsWithContext(ctx,
+		reamaze.WithContactsQuery(*query),
+		reamaze.WithContactsType(reamaze.ReamazeIdentifier(*identifierType)),
+		reamaze.WithContactsPage(*page),
+	)
+	if err != nil {
+		return err
+	}
+	t := table{header: contactsHeader}
+	for _, c := range resp.Contacts {
+		t.rows = append(t.rows, []string{c.Name, c.Email, c.Mobile, formatTime(c.CreatedAt)})
+	}
+	return a.print(resp, t)
+}
+
+func contactsGet(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	identifier, err := a.parseArg(fs, args, "identifier")
+	if err != nil {
+		return err
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetContactWithContext(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, table{header: contactsHeader, rows: [][]string{contactRow(resp)}})
+}
+
+func contactsCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	name := fs.String("name", "", "name of the contact")
+	email := fs.String("email", "", "email of the contact, required unless -mobile is set")
+	mobile := fs.String("mobile", "", "mobile phone number of the contact in E.164 format")
+	friendlyName := fs.String("friendly-name", "", "friendly name of the contact")
+	id := fs.String("id", "", "external ID of the contact")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if len(*email) == 0 && len(*mobile) == 0 {
+		return errors.New("contacts create: -email or -mobile is required")
+	}
+
+	req := &reamaze.CreateContactRequest{}
+	req.Contact.Name = *name
+	req.Contact.Email = *email
+	req.Contact.Mobile = reamaze.ReamazePhoneNumber(*mobile)
+	req.Contact.FriendlyName = *friendlyName
+	req.Contact.ID = *id
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.CreateContactWithContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, table{header: contactsHeader, rows: [][]string{contactRow(resp)}})
+}
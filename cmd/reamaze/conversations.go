@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+var conversationsHeader = []string{"SLUG", "SUBJECT", "STATUS", "CATEGORY", "AUTHOR", "TAGS", "UPDATED"}
+
+func conversationRow(c reamaze.GetConversationResponse) []string {
+	return []string{c.Slug, c.Subject, c.Status.String(), c.Category.Slug, c.Author.Email, strings.Join(c.TagList, ","), formatTime(c.UpdatedAt)}
+}
+
+func conversationsList(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	filter := fs.String("filter", "", "filter: open, unassigned, archived or all")
+	forEmail := fs.String("for", "", "only conversations of the customer with this email")
+	category := fs.String("category", "", "only conversations in this channel")
+	sortBy := fs.String("sort", "", "sort by updated or changed")
+	page := fs.Int("page", 0, "page number")
+	var tags listFlag
+	fs.Var(&tags, "tags", "only conversations with all of these comma separated tags")
+	var start, end dateFlag
+	fs.Var(&start, "start", "only conversations created since YYYY-MM-DD")
+	fs.Var(&end, "end", "only conversations created until YYYY-MM-DD")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+
+	opts := []reamaze.ConversationsOption{
+		reamaze.WithFilter(reamaze.ReamazeFilter(*filter)),
+		reamaze.WithSort(reamaze.ReamazeSort(*sortBy)),
+		reamaze.WithCategory(*category),
+		reamaze.WithPage(*page),
+		reamaze.WithTags(tags...),
+	}
+	if len(*forEmail) > 0 {
+		opts = append(opts, reamaze.WithFor(*forEmail))
+	}
+	if !start.IsZero() {
+		opts = append(opts, reamaze.WithStartDate(start.Year(), int(start.Month()), start.Day()))
+	}
+	if !end.IsZero() {
+		opts = append(opts, reamaze.WithEndDate(end.Year(), int(end.Month()), end.Day()))
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetConversationsWithContext(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	t := table{header: conversationsHeader}
+	for _, c := range resp.Conversations {
+		t.rows = append(t.rows, conversationRow(c))
+	}
+	return a.print(resp, t)
+}
+
+func conversationsGet(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	slug, err := a.parseArg(fs, args, "slug")
+	if err != nil {
+		return err
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetConversationWithContext(ctx, slug)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, table{header: conversationsHeader, rows: [][]string{conversationRow(*resp)}})
+}
+
+func conversationsCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	subject := fs.String("subject", "", "subject of the conversation")
+	category := fs.String("category", "", "slug of the channel, required")
+	body := fs.String("body", "", "body of the first message, required")
+	email := fs.String("email", "", "email of the customer, required")
+	name := fs.String("name", "", "name of the customer")
+	var status reamaze.ReamazeStatus
+	fs.TextVar(&status, "status", reamaze.ReamazeStatusUnresolved, "status of the conversation e.g. pending or resolved")
+	suppress := fs.Bool("suppress-notifications", false, "don't send notifications about the conversation")
+	var tags, attach listFlag
+	fs.Var(&tags, "tags", "comma separated tags")
+	fs.Var(&attach, "attach", "comma separated paths of files to attach to the first message")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if len(*category) == 0 || len(*body) == 0 || len(*email) == 0 {
+		return errors.New("conversations create: -category, -body and -email are required")
+	}
+
+	req := &reamaze.CreateConversationRequest{}
+	req.Conversation.Subject = *subject
+	req.Conversation.Category = *category
+	req.Conversation.TagList = tags
+	if isSet(fs, "status") {
+		req.Conversation.Status = &status
+	}
+	req.Conversation.SupressNotification = *suppress
+	req.Conversation.Message.Body = *body
+	req.Conversation.User = reamaze.User{Name: *name, Email: *email}
+	for _, path := range attach {
+		if err := req.Conversation.Message.AttachFile(path); err != nil {
+			return err
+		}
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.CreateConversationWithContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	row := []string{resp.Slug, resp.Subject, resp.Status.String(), resp.Category.Slug, resp.Author.Email, strings.Join(resp.TagList, ","), formatTime(resp.UpdatedAt)}
+	return a.print(resp, table{header: conversationsHeader, rows: [][]string{row}})
+}
+
+func conversationsUpdate(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	var status reamaze.ReamazeStatus
+	fs.TextVar(&status, "status", reamaze.ReamazeStatusUnresolved, "new status of the conversation e.g. pending or resolved")
+	assignee := fs.String("assignee", "", "email of the staff user to assign the conversation to, empty unassigns it")
+	category := fs.String("category", "", "slug of the channel to move the conversation to")
+	var tags listFlag
+	fs.Var(&tags, "tags", "comma separated tags, replacing the current ones, empty removes all tags")
+	slug, err := a.parseArg(fs, args, "slug")
+	if err != nil {
+		return err
+	}
+	if fs.NFlag() == 0 || (fs.NFlag() == 1 && isSet(fs, "o")) {
+		return errors.New("conversations update: nothing to update, please set -status, -tags, -assignee or -category")
+	}
+
+	// only flags given are sent, so that the other fields stay unchanged
+	req := &reamaze.UpdateConversationRequest{}
+	if isSet(fs, "status") {
+		req.Conversation.Status = &status
+	}
+	if isSet(fs, "tags") {
+		// empty list is sent as [] rather than null
+		list := append([]string{}, tags...)
+		req.Conversation.TagList = &list
+	}
+	if isSet(fs, "assignee") {
+		req.Conversation.Assignee = assignee
+	}
+	if isSet(fs, "category") {
+		req.Conversation.Category = category
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.UpdateConversationWithContext(ctx, slug, req)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, table{header: conversationsHeader, rows: [][]string{conversationRow(*resp)}})
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/meant4/reamaze-go/reamaze"
+	"github.com/meant4/reamaze-go/reamaze/export"
+)
+
+func conversationsExport(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	out := fs.String("out", "", "file the conversations are written to, required")
+	format := fs.String("format", "jsonl", "file format: jsonl or csv")
+	checkpointPath := fs.String("checkpoint", "", "file the progress is saved to, defaults to the output file with .checkpoint suffix")
+	window := fs.Int("window", export.DefaultWindowDays, "number of days of conversations requested at once")
+	category := fs.String("category", "", "only conversations in this channel")
+	var start, end dateFlag
+	fs.Var(&start, "start", "first day of exported conversations YYYY-MM-DD, required")
+	fs.Var(&end, "end", "last day of exported conversations YYYY-MM-DD, defaults to today")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if len(*out) == 0 || start.IsZero() {
+		return errors.New("conversations export: -out and -start are required")
+	}
+	if *format != "jsonl" && *format != "csv" {
+		return fmt.Errorf("conversations export: unknown format %q, please use jsonl or csv", *format)
+	}
+	if len(*checkpointPath) == 0 {
+		*checkpointPath = *out + ".checkpoint"
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	// the output file is appended to when resuming, and started from scratch otherwise
+	store := export.NewFileCheckpointStore(*checkpointPath)
+	checkpoint, err := store.Load()
+	if err != nil {
+		return err
+	}
+	f, err := export.OpenFile(*out, checkpoint)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	var w export.Writer = export.NewJSONLWriter(f)
+	if *format == "csv" {
+		w = export.NewCSVWriter(f, info.Size() == 0)
+	}
+
+	var last export.Checkpoint
+	if checkpoint != nil {
+		last = *checkpoint
+	}
+	opts := []export.Option{
+		export.WithWindowDays(*window),
+		export.WithCheckpointStore(store),
+		export.WithConversationsOptions(reamaze.WithCategory(*category)),
+		export.WithProgress(func(c export.Checkpoint) { last = c }),
+	}
+	if !end.IsZero() {
+		opts = append(opts, export.WithEndDate(end.Time))
+	}
+	if err := export.New(api, w, start.Time, opts...).Run(ctx); err != nil {
+		return fmt.Errorf("%w, run the same command again to resume", err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	row := []string{*out, strconv.Itoa(last.Conversations), strconv.Itoa(last.Messages)}
+	return a.print(last, table{header: []string{"FILE", "CONVERSATIONS", "MESSAGES"}, rows: [][]string{row}})
+}
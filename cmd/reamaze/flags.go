@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// dateFlag is a flag.Value holding a YYYY-MM-DD date
+type dateFlag struct {
+	time.Time
+}
+
+func (d *dateFlag) String() string {
+	if d == nil || d.IsZero() {
+		return ""
+	}
+	return d.Format(dateLayout)
+}
+
+func (d *dateFlag) Set(s string) error {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return errors.New("expected date in YYYY-MM-DD format")
+	}
+	d.Time = t
+	return nil
+}
+
+// listFlag is a flag.Value holding a comma separated list
+type listFlag []string
+
+func (l *listFlag) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(s string) error {
+	*l = nil
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// pairsFlag is a flag.Value holding key=value pairs, the flag can be repeated
+type pairsFlag [][2]string
+
+func (p *pairsFlag) String() string {
+	if p == nil {
+		return ""
+	}
+	pairs := make([]string, len(*p))
+	for i, kv := range *p {
+		pairs[i] = kv[0] + "=" + kv[1]
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (p *pairsFlag) Set(s string) error {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || len(key) == 0 {
+		return errors.New("expected key=value")
+	}
+	*p = append(*p, [2]string{key, value})
+	return nil
+}
+
+// isSet reports whether the flag was given on the command line
+func isSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
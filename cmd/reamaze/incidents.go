@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// incidentUpdate and incidentSystem are identical to the anonymous element types of
+// UpdateIncidentRequest attributes, so that they can be appended to them
+type incidentUpdate = struct {
+	Status  reamaze.ReamazeIncidentUpdateStatus `json:"status,omitempty"`
+	Message string                              `json:"message,omitempty"`
+}
+
+type incidentSystem = struct {
+	ID       string                              `json:"id,omitempty"`
+	SystemID string                              `json:"system_id,omitempty"`
+	Status   reamaze.ReamazeIncidentSystemStatus `json:"status,omitempty"`
+}
+
+var incidentsHeader = []string{"ID", "TITLE", "STATUS", "SYSTEMS", "UPDATED"}
+
+func incidentsList(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetIncidentsWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	t := table{header: incidentsHeader}
+	for _, incident := range *resp {
+		var systems []string
+		for _, s := range incident.IncidentsSystems {
+			systems = append(systems, s.System.Title+"="+s.Status)
+		}
+		t.rows = append(t.rows, []string{incident.ID, incident.Title, incident.Status, strings.Join(systems, ","), formatTime(incident.UpdatedAt)})
+	}
+	return a.print(resp, t)
+}
+
+// incidentFlags adds flags shared by incidents create and update to fs and returns function building the request
+func incidentFlags(fs *flag.FlagSet) func() *reamaze.UpdateIncidentRequest {
+	title := fs.String("title", "", "title of the incident")
+	status := fs.String("status", "", "status of the update: investigating, identified, monitoring or resolved")
+	message := fs.String("message", "", "message of the update")
+	var systems pairsFlag
+	fs.Var(&systems, "system", "affected system as system_id=status, where status is operational, degraded_performance,\npartial_outage, major_outage or under_maintenance, can be repeated")
+	return func() *reamaze.UpdateIncidentRequest {
+		req := &reamaze.UpdateIncidentRequest{}
+		req.Incident.Title = *title
+		if len(*status) > 0 || len(*message) > 0 {
+			req.Incident.UpdatesAttributes = append(req.Incident.UpdatesAttributes, incidentUpdate{
+				Status:  reamaze.ReamazeIncidentUpdateStatus(*status),
+				Message: *message,
+			})
+		}
+		for _, kv := range systems {
+			req.Incident.IncidentsSystemsAttributes = append(req.Incident.IncidentsSystemsAttributes, incidentSystem{
+				SystemID: kv[0],
+				Status:   reamaze.ReamazeIncidentSystemStatus(kv[1]),
+			})
+		}
+		return req
+	}
+}
+
+func incidentRow(resp *reamaze.GetIncidentResponse) []string {
+	var systems []string
+	for _, s := range resp.IncidentsSystems {
+		systems = append(systems, s.System.Title+"="+s.Status)
+	}
+	return []string{resp.ID, resp.Title, resp.Status, strings.Join(systems, ","), resp.UpdatedAt}
+}
+
+func incidentsCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	request := incidentFlags(fs)
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	req := request()
+	if len(req.Incident.Title) == 0 || len(req.Incident.UpdatesAttributes) == 0 || len(req.Incident.UpdatesAttributes[0].Status) == 0 {
+		return errors.New("incidents create: -title and -status are required")
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.CreateIncidentWithContext(ctx, (*reamaze.CreateIncidentRequest)(req))
+	if err != nil {
+		return err
+	}
+	return a.print(resp, table{header: incidentsHeader, rows: [][]string{incidentRow((*reamaze.GetIncidentResponse)(resp))}})
+}
+
+func incidentsUpdate(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	request := incidentFlags(fs)
+	id, err := a.parseArg(fs, args, "id")
+	if err != nil {
+		return err
+	}
+	req := request()
+	if len(req.Incident.Title) == 0 && len(req.Incident.UpdatesAttributes) == 0 && len(req.Incident.IncidentsSystemsAttributes) == 0 {
+		return errors.New("incidents update: nothing to update, please set -title, -status, -message or -system")
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.UpdateIncidentWithContext(ctx, id, req)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, table{header: incidentsHeader, rows: [][]string{incidentRow((*reamaze.GetIncidentResponse)(resp))}})
+}
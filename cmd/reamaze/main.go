@@ -0,0 +1,218 @@
+// Command reamaze is a command-line client of the re:amaze API built on the reamaze package.
+//
+// Usage:
+//
+//	reamaze [-o table|json|csv] [-config file] <resource> <action> [flags] [arguments]
+//
+// For example:
+//
+//	reamaze conversations list -filter open
+//	reamaze conversations get order-issue-123
+//	reamaze -o json contacts get john@example.com
+//	reamaze messages send -body "Thanks, it's fixed now" order-issue-123
+//
+// Credentials are read from REAMAZE_EMAIL, REAMAZE_API_TOKEN and REAMAZE_BRAND environment variables,
+// missing ones are read from the config file, see loadConfig.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// command is a single action on a resource e.g. conversations list
+type command struct {
+	resource string
+	action   string
+	args     string
+	summary  string
+	run      func(ctx context.Context, a *app, args []string) error
+}
+
+// commands are all the commands in the order they are listed in usage
+var commands = []command{
+	{resource: "conversations", action: "list", summary: "list conversations", run: conversationsList},
+	{resource: "conversations", action: "get", args: "<slug>", summary: "show a conversation", run: conversationsGet},
+	{resource: "conversations", action: "create", summary: "create a conversation", run: conversationsCreate},
+	{resource: "conversations", action: "update", args: "<slug>", summary: "update status, tags, assignee or category of a conversation", run: conversationsUpdate},
+	{resource: "conversations", action: "export", summary: "export conversations with their messages to a JSONL or CSV file, resumable", run: conversationsExport},
+	{resource: "messages", action: "list", args: "[<slug>]", summary: "list messages, of a single conversation when slug is given", run: messagesList},
+	{resource: "messages", action: "send", args: "<slug>", summary: "add a message or an internal note to a conversation", run: messagesSend},
+	{resource: "contacts", action: "list", summary: "list contacts", run: contactsList},
+	{resource: "contacts", action: "get", args: "<identifier>", summary: "show a contact", run: contactsGet},
+	{resource: "contacts", action: "create", summary: "create a contact", run: contactsCreate},
+	{resource: "articles", action: "list", summary: "list knowledge base articles", run: articlesList},
+	{resource: "reports", action: "volume", summary: "show daily conversation counts", run: reportsVolume},
+	{resource: "reports", action: "staff", summary: "rank staff by responses, resolved conversations or response time", run: reportsStaff},
+	{resource: "incidents", action: "list", summary: "list status page incidents", run: incidentsList},
+	{resource: "incidents", action: "create", summary: "create a status page incident", run: incidentsCreate},
+	{resource: "incidents", action: "update", args: "<id>", summary: "post an update to a status page incident", run: incidentsUpdate},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	err := run(ctx, os.Args[1:], os.Getenv, os.Stdout)
+	stop()
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "reamaze:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command given in args, getenv is used instead of os.Getenv to read the configuration
+func run(ctx context.Context, args []string, getenv func(string) string, stdout io.Writer) error {
+	a := &app{stdout: stdout, format: formatTable}
+	fs := flag.NewFlagSet("reamaze", flag.ContinueOnError)
+	fs.StringVar(&a.format, "o", a.format, "output format: table, json or csv")
+	configPath := fs.String("config", "", "config file, defaults to $REAMAZE_CONFIG or reamaze/config in the user config directory")
+	fs.Usage = func() { printUsage(fs) }
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	a.connect = func() (reamaze.API, error) {
+		cfg, err := loadConfig(*configPath, getenv)
+		if err != nil {
+			return nil, err
+		}
+		return cfg.newClient()
+	}
+	return a.dispatch(ctx, fs.Args())
+}
+
+func printUsage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintf(w, "Usage: reamaze [flags] <resource> <action> [flags] [arguments]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-40s %s\n", strings.TrimSpace(c.resource+" "+c.action+" "+c.args), c.summary)
+	}
+	fmt.Fprintf(w, "\nFlags:\n")
+	fs.PrintDefaults()
+	fmt.Fprintf(w, "\nRun 'reamaze <resource> <action> -h' for flags of the command.\n")
+}
+
+// app holds state shared by all commands
+type app struct {
+	stdout io.Writer
+	format string
+	// command is the command being run
+	command command
+	// api is created by connect on first use, so that help works without credentials
+	api     reamaze.API
+	connect func() (reamaze.API, error)
+}
+
+// dispatch runs the command named by the first two arguments
+func (a *app) dispatch(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errors.New("expected resource and action, run 'reamaze -h' for the list of commands")
+	}
+	for _, c := range commands {
+		if c.resource == args[0] && c.action == args[1] {
+			a.command = c
+			return c.run(ctx, a, args[2:])
+		}
+	}
+	var actions []string
+	for _, c := range commands {
+		if c.resource == args[0] {
+			actions = append(actions, c.action)
+		}
+	}
+	if len(actions) == 0 {
+		return fmt.Errorf("unknown resource %q, run 'reamaze -h' for the list of commands", args[0])
+	}
+	sort.Strings(actions)
+	return fmt.Errorf("unknown action %q, %s supports: %s", args[1], args[0], strings.Join(actions, ", "))
+}
+
+// client returns the API client, creating it on first use
+func (a *app) client() (reamaze.API, error) {
+	if a.api == nil {
+		api, err := a.connect()
+		if err != nil {
+			return nil, err
+		}
+		a.api = api
+	}
+	return a.api, nil
+}
+
+// flags returns the flag set of the command being run, -o can be given after the command too
+func (a *app) flags() *flag.FlagSet {
+	c := a.command
+	fs := flag.NewFlagSet(c.resource+" "+c.action, flag.ContinueOnError)
+	fs.StringVar(&a.format, "o", a.format, "output format: table, json or csv")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: reamaze %s %s [flags] %s\n\n%s\n\nFlags:\n", c.resource, c.action, c.args, c.summary)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses args printing usage to stdout only when help is requested, other errors are reported by main
+func (a *app) parse(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(io.Discard)
+	err := fs.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		fs.SetOutput(a.stdout)
+		fs.Usage()
+	}
+	if err != nil {
+		return err
+	}
+	return a.checkFormat()
+}
+
+// parseArg parses flags of a command taking exactly one argument, which can be given before or after the flags
+func (a *app) parseArg(fs *flag.FlagSet, args []string, name string) (string, error) {
+	arg, err := a.parseOptionalArg(fs, args)
+	if err != nil {
+		return "", err
+	}
+	if len(arg) == 0 {
+		return "", fmt.Errorf("%s: missing %s argument", fs.Name(), name)
+	}
+	return arg, nil
+}
+
+// parseOptionalArg is like parseArg but returns empty string when the argument isn't given
+func (a *app) parseOptionalArg(fs *flag.FlagSet, args []string) (string, error) {
+	var arg string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		arg, args = args[0], args[1:]
+	}
+	if err := a.parse(fs, args); err != nil {
+		return "", err
+	}
+	rest := fs.Args()
+	if len(arg) == 0 && len(rest) > 0 {
+		arg, rest = rest[0], rest[1:]
+	}
+	if len(rest) > 0 {
+		return "", fmt.Errorf("%s: unexpected arguments %s", fs.Name(), strings.Join(rest, " "))
+	}
+	return arg, nil
+}
+
+// parseNoArgs parses flags of a command taking no arguments
+func (a *app) parseNoArgs(fs *flag.FlagSet, args []string) error {
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%s: unexpected arguments %s", fs.Name(), strings.Join(fs.Args(), " "))
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+	"github.com/meant4/reamaze-go/reamaze/reamazemock"
+	"github.com/meant4/reamaze-go/reamaze/reamazetest"
+)
+
+// newTestEnv starts reamazetest.Server with a support channel and returns getenv pointing the CLI at it
+func newTestEnv(t *testing.T) (*reamazetest.Server, func(string) string) {
+	t.Helper()
+	srv := reamazetest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	env := map[string]string{
+		keyEmail:    reamazetest.Email,
+		keyAPIToken: reamazetest.APIToken,
+		keyBrand:    reamazetest.Brand,
+		keyBaseURL:  srv.URL,
+		// making sure config file of the user running tests isn't read
+		keyConfig: filepath.Join(t.TempDir(), "config"),
+	}
+	if err := os.WriteFile(env[keyConfig], nil, 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	return srv, func(key string) string { return env[key] }
+}
+
+// runCLI runs the CLI with args returning its output
+func runCLI(t *testing.T, getenv func(string) string, args ...string) (string, error) {
+	t.Helper()
+	var stdout bytes.Buffer
+	err := run(context.Background(), args, getenv, &stdout)
+	return stdout.String(), err
+}
+
+func TestConversations(t *testing.T) {
+	_, getenv := newTestEnv(t)
+
+	out, err := runCLI(t, getenv, "-o", "json", "conversations", "create",
+		"-subject", "Order issue", "-category", "support", "-body", "My order didn't arrive",
+		"-email", "john@example.com", "-name", "John", "-tags", "order, urgent")
+	if err != nil {
+		t.Fatalf("conversations create error = %v", err)
+	}
+	var created reamaze.CreateConversationResponse
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, output %s", err, out)
+	}
+	if created.Subject != "Order issue" || created.Author.Email != "john@example.com" || !reflect.DeepEqual(created.TagList, []string{"order", "urgent"}) {
+		t.Errorf("conversations create = %+v", created)
+	}
+
+	// flags can be given after the slug
+	if _, err := runCLI(t, getenv, "conversations", "update", created.Slug, "-status", "resolved", "-tags", "order"); err != nil {
+		t.Fatalf("conversations update error = %v", err)
+	}
+	out, err = runCLI(t, getenv, "conversations", "get", created.Slug, "-o", "csv")
+	if err != nil {
+		t.Fatalf("conversations get error = %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll() error = %v, output %s", err, out)
+	}
+	if len(records) != 2 || !reflect.DeepEqual(records[0], conversationsHeader) {
+		t.Fatalf("conversations get = %v", records)
+	}
+	if got := records[1][:6]; !reflect.DeepEqual(got, []string{created.Slug, "Order issue", "resolved", "support", "john@example.com", "order"}) {
+		t.Errorf("conversations get = %v", got)
+	}
+
+	out, err = runCLI(t, getenv, "conversations", "list", "-filter", "all", "-tags", "order")
+	if err != nil {
+		t.Fatalf("conversations list error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "SLUG ") || !strings.HasPrefix(lines[1], created.Slug+" ") {
+		t.Errorf("conversations list = %q", out)
+	}
+
+	// reopening with zero status and removing tags
+	out, err = runCLI(t, getenv, "-o", "csv", "conversations", "update", created.Slug, "-status", "unresolved", "-tags", "")
+	if err != nil {
+		t.Fatalf("conversations update error = %v", err)
+	}
+	if records, err := csv.NewReader(strings.NewReader(out)).ReadAll(); err != nil || len(records) != 2 || records[1][2] != "unresolved" || records[1][5] != "" {
+		t.Errorf("conversations update = %v, error = %v", records, err)
+	}
+}
+
+func TestMessages(t *testing.T) {
+	srv, getenv := newTestEnv(t)
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("Server.NewClient() error = %v", err)
+	}
+	req := &reamaze.CreateConversationRequest{}
+	req.Conversation.Subject = "Question"
+	req.Conversation.Category = "support"
+	req.Conversation.Message.Body = "Hello"
+	req.Conversation.User = reamaze.User{Email: "john@example.com"}
+	conversation, err := client.CreateConversation(req)
+	if err != nil {
+		t.Fatalf("CreateConversation() error = %v", err)
+	}
+
+	attachment := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(attachment, []byte("notes"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	if _, err := runCLI(t, getenv, "messages", "send", conversation.Slug, "-body", "Checking", "-internal", "-attach", attachment); err != nil {
+		t.Fatalf("messages send error = %v", err)
+	}
+	out, err := runCLI(t, getenv, "-o", "csv", "messages", "list", conversation.Slug)
+	if err != nil {
+		t.Fatalf("messages list error = %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll() error = %v, output %s", err, out)
+	}
+	if len(records) != 3 || records[1][2] != reamazetest.Email || records[1][3] != "1" || records[1][4] != "Checking" || records[2][4] != "Hello" {
+		t.Errorf("messages list = %v", records)
+	}
+	messages, err := client.GetConversationMessages(conversation.Slug)
+	if err != nil || len(messages.Messages[0].Attachments) != 1 || messages.Messages[0].Attachments[0].FileFileName != "notes.txt" {
+		t.Errorf("GetConversationMessages() = %+v, error = %v", messages, err)
+	}
+}
+
+func TestContacts(t *testing.T) {
+	_, getenv := newTestEnv(t)
+	if _, err := runCLI(t, getenv, "contacts", "create", "-name", "John", "-email", "john@example.com"); err != nil {
+		t.Fatalf("contacts create error = %v", err)
+	}
+	out, err := runCLI(t, getenv, "-o", "json", "contacts", "get", "john@example.com")
+	if err != nil {
+		t.Fatalf("contacts get error = %v", err)
+	}
+	var contact reamaze.GetContactResponse
+	if err := json.Unmarshal([]byte(out), &contact); err != nil || contact.Name != "John" {
+		t.Errorf("contacts get = %s, error = %v", out, err)
+	}
+	if _, err := runCLI(t, getenv, "contacts", "get", "dummy@example.com"); !errors.Is(err, reamaze.ErrNotFound) {
+		t.Errorf("contacts get error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestIncidents(t *testing.T) {
+	srv, getenv := newTestEnv(t)
+	systemID := srv.AddSystem("API")
+	out, err := runCLI(t, getenv, "-o", "json", "incidents", "create",
+		"-title", "API outage", "-status", "investigating", "-message", "Looking into it", "-system", systemID+"=major_outage")
+	if err != nil {
+		t.Fatalf("incidents create error = %v", err)
+	}
+	var incident reamaze.GetIncidentResponse
+	if err := json.Unmarshal([]byte(out), &incident); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, output %s", err, out)
+	}
+	if _, err := runCLI(t, getenv, "incidents", "update", incident.ID, "-status", "resolved", "-message", "Fixed"); err != nil {
+		t.Fatalf("incidents update error = %v", err)
+	}
+	out, err = runCLI(t, getenv, "-o", "csv", "incidents", "list")
+	if err != nil {
+		t.Fatalf("incidents list error = %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil || len(records) != 2 || !reflect.DeepEqual(records[1][:3], []string{incident.ID, "API outage", "resolved"}) {
+		t.Errorf("incidents list = %v, error = %v", records, err)
+	}
+}
+
+func TestReportsVolume(t *testing.T) {
+	mock := &reamazemock.Mock{
+		GetReportsVolumeWithContextFunc: func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error) {
+			return &reamaze.GetReportsVolumeResponse{ConversationCounts: map[string]int{"2024-01-02": 5, "2024-01-01": 3}}, nil
+		},
+	}
+	var stdout bytes.Buffer
+	a := &app{stdout: &stdout, format: formatCSV, api: mock}
+	if err := a.dispatch(context.Background(), []string{"reports", "volume", "-start", "2024-01-01", "-end", "2024-01-02"}); err != nil {
+		t.Fatalf("reports volume error = %v", err)
+	}
+	if want := "DATE,CONVERSATIONS\n2024-01-01,3\n2024-01-02,5\n"; stdout.String() != want {
+		t.Errorf("reports volume = %q, want %q", stdout.String(), want)
+	}
+	calls := mock.Calls()
+	want := []any{[]reamaze.ReportsOption{reamaze.WithReportsStartDate(2024, 1, 1), reamaze.WithReportsEndDate(2024, 1, 2)}}
+	if len(calls) != 1 || !reflect.DeepEqual(calls[0].Args, want) {
+		t.Errorf("Calls() = %v", calls)
+	}
+}
+
+func TestReportsStaff(t *testing.T) {
+	mock := &reamazemock.Mock{
+		GetReportsStaffWithContextFunc: func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsStaffResponse, error) {
+			return &reamaze.GetReportsStaffResponse{Report: map[string]reamaze.StaffReportMetrics{
+				"Jane Doe":         {ResponseCount: 12, ResolvedCount: 8, ResponseTimeSeconds: 300.4},
+				"John Smith":       {ResponseCount: 20, ResolvedCount: 5, ResponseTimeSeconds: 90},
+				"anna@example.com": {},
+			}}, nil
+		},
+	}
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "Testing default ranking",
+			args: []string{"reports", "staff", "-start", "2024-01-01"},
+			want: "STAFF,RESPONSES,RESOLVED,RESPONSE TIME (S)\nJohn Smith,20,5,90\nJane Doe,12,8,300\nanna@example.com,0,0,0\n",
+		},
+		{
+			name: "Testing ranking by resolved",
+			args: []string{"reports", "staff", "-sort", "resolved"},
+			want: "STAFF,RESPONSES,RESOLVED,RESPONSE TIME (S)\nJane Doe,12,8,300\nJohn Smith,20,5,90\nanna@example.com,0,0,0\n",
+		},
+		{
+			name: "Testing ranking by response time",
+			args: []string{"reports", "staff", "-sort", "response_time"},
+			want: "STAFF,RESPONSES,RESOLVED,RESPONSE TIME (S)\nJohn Smith,20,5,90\nJane Doe,12,8,300\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			a := &app{stdout: &stdout, format: formatCSV, api: mock}
+			if err := a.dispatch(context.Background(), tt.args); err != nil {
+				t.Fatalf("reports staff error = %v", err)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("reports staff = %q, want %q", stdout.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	_, getenv := newTestEnv(t)
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "Testing missing command", args: nil, want: "expected resource and action"},
+		{name: "Testing unknown resource", args: []string{"dummy", "list"}, want: `unknown resource "dummy"`},
+		{name: "Testing unknown action", args: []string{"conversations", "dummy"}, want: "conversations supports: create, export, get, list, update"},
+		{name: "Testing unknown output format", args: []string{"-o", "xml", "conversations", "list"}, want: `unknown output format "xml"`},
+		{name: "Testing unknown flag", args: []string{"conversations", "list", "-dummy"}, want: "flag provided but not defined: -dummy"},
+		{name: "Testing missing argument", args: []string{"conversations", "get"}, want: "missing slug argument"},
+		{name: "Testing unexpected argument", args: []string{"conversations", "get", "a", "b"}, want: "unexpected arguments b"},
+		{name: "Testing missing required flags", args: []string{"conversations", "create", "-body", "Hello"}, want: "-category, -body and -email are required"},
+		{name: "Testing nothing to update", args: []string{"conversations", "update", "dummy", "-o", "json"}, want: "nothing to update"},
+		{name: "Testing unknown status", args: []string{"conversations", "update", "dummy", "-status", "closed"}, want: `unknown status "closed"`},
+		{name: "Testing invalid date", args: []string{"reports", "volume", "-start", "01/02/2024"}, want: "expected date in YYYY-MM-DD format"},
+		{name: "Testing unknown staff sort", args: []string{"reports", "staff", "-sort", "dummy"}, want: `unknown sort "dummy"`},
+		{name: "Testing invalid system", args: []string{"incidents", "update", "dummy", "-system", "dummy"}, want: "expected key=value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runCLI(t, getenv, tt.args...)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("run() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	noEnv := func(string) string { return "" }
+	out, err := runCLI(t, noEnv, "-h")
+	if !errors.Is(err, flag.ErrHelp) || !strings.Contains(out, "incidents update <id>") {
+		t.Errorf("run(-h) = %q, error = %v", out, err)
+	}
+	// help of a command doesn't need credentials
+	out, err = runCLI(t, noEnv, "messages", "send", "-h")
+	if !errors.Is(err, flag.ErrHelp) || !strings.Contains(out, "Usage: reamaze messages send [flags] <slug>") || !strings.Contains(out, "-internal") {
+		t.Errorf("run(messages send -h) = %q, error = %v", out, err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	content := "# re:amaze credentials\nREAMAZE_EMAIL=file@example.com\nREAMAZE_API_TOKEN = \"file-token\"\n\nREAMAZE_BRAND=file-brand\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	emptyPath := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(emptyPath, nil, 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	badPath := filepath.Join(t.TempDir(), "bad")
+	if err := os.WriteFile(badPath, []byte("REAMAZE_EMAIL\n"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		env     map[string]string
+		want    config
+		wantErr bool
+	}{
+		{
+			name: "Testing config file",
+			path: path,
+			want: config{Email: "file@example.com", APIToken: "file-token", Brand: "file-brand"},
+		},
+		{
+			name: "Testing environment overriding config file",
+			env:  map[string]string{keyConfig: path, keyBrand: "env-brand", keyBaseURL: "http://127.0.0.1:8080"},
+			want: config{Email: "file@example.com", APIToken: "file-token", Brand: "env-brand", BaseURL: "http://127.0.0.1:8080"},
+		},
+		{
+			name:    "Testing missing credentials",
+			env:     map[string]string{keyConfig: emptyPath, keyEmail: "env@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "Testing missing config file",
+			path:    filepath.Join(t.TempDir(), "dummy"),
+			wantErr: true,
+		},
+		{
+			name:    "Testing invalid config file",
+			path:    badPath,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadConfig(tt.path, func(key string) string { return tt.env[key] })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("loadConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConversationsExport(t *testing.T) {
+	srv, getenv := newTestEnv(t)
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("Server.NewClient() error = %v", err)
+	}
+	req := &reamaze.CreateConversationRequest{}
+	req.Conversation.Category = "support"
+	req.Conversation.Message.Body = "Hello"
+	req.Conversation.User = reamaze.User{Email: "john@example.com"}
+	if _, err := client.CreateConversation(req); err != nil {
+		t.Fatalf("CreateConversation() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "export.csv")
+	today := time.Now().UTC().Format("2006-01-02")
+	out, err := runCLI(t, getenv, "-o", "csv", "conversations", "export", "-out", path, "-format", "csv", "-start", today)
+	if err != nil {
+		t.Fatalf("conversations export error = %v", err)
+	}
+	if want := "FILE,CONVERSATIONS,MESSAGES\n" + path + ",1,1\n"; out != want {
+		t.Errorf("conversations export = %q, want %q", out, want)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = %v", err)
+	}
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil || len(records) != 2 || records[1][12] != "Hello" {
+		t.Errorf("exported %v, error = %v", records, err)
+	}
+	// completed export is reported again without exporting anything
+	if out, err := runCLI(t, getenv, "-o", "csv", "conversations", "export", "-out", path, "-format", "csv", "-start", today); err != nil || !strings.HasSuffix(out, ",1,1\n") {
+		t.Errorf("conversations export = %q, error = %v", out, err)
+	}
+	if data2, _ := os.ReadFile(path); !bytes.Equal(data, data2) {
+		t.Errorf("completed export changed the file")
+	}
+}
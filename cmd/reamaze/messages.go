@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+var messagesHeader = []string{"CREATED", "CONVERSATION", "AUTHOR", "VISIBILITY", "BODY"}
+
+func messagesList(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	page := fs.Int("page", 0, "page number")
+	internal := fs.Bool("internal", false, "only internal notes")
+	var start, end dateFlag
+	fs.Var(&start, "start", "only messages created since YYYY-MM-DD")
+	fs.Var(&end, "end", "only messages created until YYYY-MM-DD")
+	slug, err := a.parseOptionalArg(fs, args)
+	if err != nil {
+		return err
+	}
+
+	opts := []reamaze.MessagesOption{reamaze.WithMessagesPage(*page)}
+	if *internal {
+		opts = append(opts, reamaze.WithMessagesVisibility(reamaze.ReamazeVisibilityInternalNote))
+	}
+	if !start.IsZero() {
+		opts = append(opts, reamaze.WithMessagesStartDate(start.Year(), int(start.Month()), start.Day()))
+	}
+	if !end.IsZero() {
+		opts = append(opts, reamaze.WithMessagesEndDate(end.Year(), int(end.Month()), end.Day()))
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	var resp *reamaze.GetMessagesResponse
+	if len(slug) > 0 {
+		resp, err = api.GetConversationMessagesWithContext(ctx, slug, opts...)
+	} else {
+		resp, err = api.GetMessagesWithContext(ctx, opts...)
+	}
+	if err != nil {
+		return err
+	}
+	t := table{header: messagesHeader}
+	for _, m := range resp.Messages {
+		t.rows = append(t.rows, []string{formatTime(m.CreatedAt), m.Conversation.Slug, m.User.Email, strconv.Itoa(m.Visibility), m.Body})
+	}
+	return a.print(resp, t)
+}
+
+func messagesSend(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	body := fs.String("body", "", "body of the message, required")
+	internal := fs.Bool("internal", false, "add an internal note visible only to staff")
+	email := fs.String("email", "", "email of the sender, defaults to the API user")
+	suppressNotifications := fs.Bool("suppress-notifications", false, "don't send notifications about the message")
+	suppressAutoresolve := fs.Bool("suppress-autoresolve", false, "don't resolve the conversation when the sender is staff")
+	var attach listFlag
+	fs.Var(&attach, "attach", "comma separated paths of files to attach")
+	slug, err := a.parseArg(fs, args, "slug")
+	if err != nil {
+		return err
+	}
+	if len(*body) == 0 {
+		return errors.New("messages send: -body is required")
+	}
+
+	req := &reamaze.CreateMessageRequest{Message: reamaze.MessageRequest{
+		Body:                *body,
+		SupressNotification: *suppressNotifications,
+		SupressAutoresolve:  *suppressAutoresolve,
+	}}
+	if *internal {
+		req.Message.Visibility = reamaze.ReamazeVisibilityInternalNote
+	}
+	if len(*email) > 0 {
+		req.Message.User = &reamaze.User{Email: *email}
+	}
+	for _, path := range attach {
+		if err := req.Message.AttachFile(path); err != nil {
+			return err
+		}
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.CreateMessageWithContext(ctx, slug, req)
+	if err != nil {
+		return err
+	}
+	row := []string{resp.CreatedAt, resp.Conversation.Slug, resp.User.Email, strconv.Itoa(resp.Visibility), resp.Body}
+	return a.print(resp, table{header: messagesHeader, rows: [][]string{row}})
+}
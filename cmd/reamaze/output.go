@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// maxCellWidth is the number of characters table cells are truncated to, csv and json output is never truncated
+const maxCellWidth = 60
+
+// table is the tabular form of a response used by table and csv output
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// checkFormat returns error for unsupported output formats, so that commands fail before calling the API
+func (a *app) checkFormat() error {
+	switch a.format {
+	case formatTable, formatJSON, formatCSV:
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q, please use table, json or csv", a.format)
+}
+
+// print writes v as indented JSON, or t as table or CSV, depending on the output format
+func (a *app) print(v any, t table) error {
+	switch a.format {
+	case formatJSON:
+		enc := json.NewEncoder(a.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		w := csv.NewWriter(a.stdout)
+		if err := w.Write(t.header); err != nil {
+			return err
+		}
+		return w.WriteAll(t.rows)
+	}
+	w := tabwriter.NewWriter(a.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = tableCell(cell)
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	return w.Flush()
+}
+
+// tableCell returns s on a single line, truncated to maxCellWidth characters
+func tableCell(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if runes := []rune(s); len(runes) > maxCellWidth {
+		s = string(runes[:maxCellWidth-3]) + "..."
+	}
+	return s
+}
+
+// formatTime returns t in RFC 3339 format, or empty string for zero time
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+func reportsVolume(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	var start, end dateFlag
+	fs.Var(&start, "start", "first day of the report YYYY-MM-DD")
+	fs.Var(&end, "end", "last day of the report YYYY-MM-DD")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetReportsVolumeWithContext(ctx, reportsOptions(start, end)...)
+	if err != nil {
+		return err
+	}
+	dates := make([]string, 0, len(resp.ConversationCounts))
+	for date := range resp.ConversationCounts {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	t := table{header: []string{"DATE", "CONVERSATIONS"}}
+	for _, date := range dates {
+		t.rows = append(t.rows, []string{date, strconv.Itoa(resp.ConversationCounts[date])})
+	}
+	return a.print(resp, t)
+}
+
+func reportsStaff(ctx context.Context, a *app, args []string) error {
+	fs := a.flags()
+	var start, end dateFlag
+	fs.Var(&start, "start", "first day of the report YYYY-MM-DD")
+	fs.Var(&end, "end", "last day of the report YYYY-MM-DD")
+	sortBy := fs.String("sort", "responses", "rank staff by: responses, resolved or response_time")
+	if err := a.parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	var rank func(*reamaze.GetReportsStaffResponse) []reamaze.StaffRank
+	switch *sortBy {
+	case "responses":
+		rank = (*reamaze.GetReportsStaffResponse).RankByResponseCount
+	case "resolved":
+		rank = (*reamaze.GetReportsStaffResponse).RankByResolvedCount
+	case "response_time":
+		rank = (*reamaze.GetReportsStaffResponse).RankByResponseTime
+	default:
+		return fmt.Errorf("unknown sort %q, expected responses, resolved or response_time", *sortBy)
+	}
+	api, err := a.client()
+	if err != nil {
+		return err
+	}
+	resp, err := api.GetReportsStaffWithContext(ctx, reportsOptions(start, end)...)
+	if err != nil {
+		return err
+	}
+	ranking := rank(resp)
+	t := table{header: []string{"STAFF", "RESPONSES", "RESOLVED", "RESPONSE TIME (S)"}}
+	for _, s := range ranking {
+		t.rows = append(t.rows, []string{s.Staff, strconv.Itoa(s.ResponseCount), strconv.Itoa(s.ResolvedCount),
+			strconv.FormatFloat(s.ResponseTimeSeconds, 'f', 0, 64)})
+	}
+	return a.print(ranking, t)
+}
+
+// reportsOptions returns report options for the dates given with -start and -end
+func reportsOptions(start, end dateFlag) []reamaze.ReportsOption {
+	var opts []reamaze.ReportsOption
+	if !start.IsZero() {
+		opts = append(opts, reamaze.WithReportsStartDate(start.Year(), int(start.Month()), start.Day()))
+	}
+	if !end.IsZero() {
+		opts = append(opts, reamaze.WithReportsEndDate(end.Year(), int(end.Month()), end.Day()))
+	}
+	return opts
+}
@@ -0,0 +1,170 @@
+package reamaze
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run ./internal/genmock -in api.go -out reamazemock/mock_gen.go
+
+// API is implemented by *Client, depend on it (or on the per-resource services) instead of *Client
+// to inject fakes in tests or wrap the client with decorators e.g. caching or logging.
+// Pagination helpers such as ConversationsIter are built on top of these methods and are available on *Client only.
+type API interface {
+	ArticlesService
+	ChannelsService
+	ContactsService
+	ConversationsService
+	IncidentsService
+	MessagesService
+	NotesService
+	ReportsService
+	ResponseTemplatesService
+	StaffService
+	SystemsService
+}
+
+// ArticlesService covers https://www.reamaze.com/api/get_articles endpoints
+type ArticlesService interface {
+	GetArticles(o ...ArticlesOption) (*GetArticlesResponse, error)
+	GetArticlesWithContext(ctx context.Context, o ...ArticlesOption) (*GetArticlesResponse, error)
+	GetArticle(slug string) (*GetArticleResponse, error)
+	GetArticleWithContext(ctx context.Context, slug string) (*GetArticleResponse, error)
+	CreateArticle(req *CreateArticleRequest) (*CreateArticleResponse, error)
+	CreateArticleWithContext(ctx context.Context, req *CreateArticleRequest) (*CreateArticleResponse, error)
+	UpdateArticle(slug string, req *UpdateArticleRequest) (*UpdateArticleResponse, error)
+	UpdateArticleWithContext(ctx context.Context, slug string, req *UpdateArticleRequest) (*UpdateArticleResponse, error)
+}
+
+// ChannelsService covers https://www.reamaze.com/api/get_channels endpoints
+type ChannelsService interface {
+	GetChannels() (*GetChannelsResponse, error)
+	GetChannelsWithContext(ctx context.Context) (*GetChannelsResponse, error)
+	GetChannel(slug string) (*GetChannelResponse, error)
+	GetChannelWithContext(ctx context.Context, slug string) (*GetChannelResponse, error)
+}
+
+// ContactsService covers https://www.reamaze.com/api/get_contacts endpoints including contact identities
+type ContactsService interface {
+	GetContacts(o ...ContactsOption) (*GetContactsResponse, error)
+	GetContactsWithContext(ctx context.Context, o ...ContactsOption) (*GetContactsResponse, error)
+	GetContact(identifier string) (*GetContactResponse, error)
+	GetContactWithContext(ctx context.Context, identifier string) (*GetContactResponse, error)
+	CreateContact(req *CreateContactRequest) (*GetContactResponse, error)
+	CreateContactWithContext(ctx context.Context, req *CreateContactRequest) (*GetContactResponse, error)
+	UpdateContact(identifier string, req *UpdateContactRequest, identifierType ...ReamazeIdentifier) (*GetContactResponse, error)
+	UpdateContactWithContext(ctx context.Context, identifier string, req *UpdateContactRequest, identifierType ...ReamazeIdentifier) (*GetContactResponse, error)
+	GetContactIdentities(identifier string) (*GetContactIdentitiesResponse, error)
+	GetContactIdentitiesWithContext(ctx context.Context, identifier string) (*GetContactIdentitiesResponse, error)
+	CreateContactIdentities(identifier string, req *CreateContactIdentitiesRequest, identifierType ...ReamazeIdentifier) (*GetContactIdentitiesResponse, error)
+	CreateContactIdentitiesWithContext(ctx context.Context, identifier string, req *CreateContactIdentitiesRequest, identifierType ...ReamazeIdentifier) (*GetContactIdentitiesResponse, error)
+}
+
+// ConversationsService covers https://www.reamaze.com/api/get_conversations endpoints
+type ConversationsService interface {
+	GetConversations(o ...ConversationsOption) (*GetConversationsResponse, error)
+	GetConversationsWithContext(ctx context.Context, o ...ConversationsOption) (*GetConversationsResponse, error)
+	GetConversation(slug string) (*GetConversationResponse, error)
+	GetConversationWithContext(ctx context.Context, slug string) (*GetConversationResponse, error)
+	CreateConversation(req *CreateConversationRequest) (*CreateConversationResponse, error)
+	CreateConversationWithContext(ctx context.Context, req *CreateConversationRequest) (*CreateConversationResponse, error)
+	UpdateConversation(slug string, req *UpdateConversationRequest) (*GetConversationResponse, error)
+	UpdateConversationWithContext(ctx context.Context, slug string, req *UpdateConversationRequest) (*GetConversationResponse, error)
+	AssignConversation(slug string, staffEmail string) (*GetConversationResponse, error)
+	AssignConversationWithContext(ctx context.Context, slug string, staffEmail string) (*GetConversationResponse, error)
+	AddConversationTags(slug string, tags ...string) (*GetConversationResponse, error)
+	AddConversationTagsWithContext(ctx context.Context, slug string, tags ...string) (*GetConversationResponse, error)
+	RemoveConversationTags(slug string, tags ...string) (*GetConversationResponse, error)
+	RemoveConversationTagsWithContext(ctx context.Context, slug string, tags ...string) (*GetConversationResponse, error)
+	ResolveConversation(slug string) (*GetConversationResponse, error)
+	ResolveConversationWithContext(ctx context.Context, slug string) (*GetConversationResponse, error)
+	ReopenConversation(slug string) (*GetConversationResponse, error)
+	ReopenConversationWithContext(ctx context.Context, slug string) (*GetConversationResponse, error)
+	MarkConversationSpam(slug string) (*GetConversationResponse, error)
+	MarkConversationSpamWithContext(ctx context.Context, slug string) (*GetConversationResponse, error)
+	HoldConversationUntil(slug string, until time.Time) (*GetConversationResponse, error)
+	HoldConversationUntilWithContext(ctx context.Context, slug string, until time.Time) (*GetConversationResponse, error)
+	MoveConversationToCategory(slug string, category string) (*GetConversationResponse, error)
+	MoveConversationToCategoryWithContext(ctx context.Context, slug string, category string) (*GetConversationResponse, error)
+}
+
+// IncidentsService covers https://www.reamaze.com/api/get_incidents endpoints
+type IncidentsService interface {
+	GetIncidents() (*GetIncidentsResponse, error)
+	GetIncidentsWithContext(ctx context.Context) (*GetIncidentsResponse, error)
+	GetIncident(identifier string) (*GetIncidentResponse, error)
+	GetIncidentWithContext(ctx context.Context, identifier string) (*GetIncidentResponse, error)
+	CreateIncident(req *CreateIncidentRequest) (*CreateIncidentResponse, error)
+	CreateIncidentWithContext(ctx context.Context, req *CreateIncidentRequest) (*CreateIncidentResponse, error)
+	UpdateIncident(identifier string, req *UpdateIncidentRequest) (*UpdateIncidentResponse, error)
+	UpdateIncidentWithContext(ctx context.Context, identifier string, req *UpdateIncidentRequest) (*UpdateIncidentResponse, error)
+}
+
+// MessagesService covers https://www.reamaze.com/api/get_messages endpoints
+type MessagesService interface {
+	GetMessages(o ...MessagesOption) (*GetMessagesResponse, error)
+	GetMessagesWithContext(ctx context.Context, o ...MessagesOption) (*GetMessagesResponse, error)
+	GetConversationMessages(slug string, o ...MessagesOption) (*GetMessagesResponse, error)
+	GetConversationMessagesWithContext(ctx context.Context, slug string, o ...MessagesOption) (*GetMessagesResponse, error)
+	CreateMessage(slug string, req *CreateMessageRequest) (*CreateMessageResponse, error)
+	CreateMessageWithContext(ctx context.Context, slug string, req *CreateMessageRequest) (*CreateMessageResponse, error)
+	ReplyToConversation(slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error)
+	ReplyToConversationWithContext(ctx context.Context, slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error)
+	AddInternalNote(slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error)
+	AddInternalNoteWithContext(ctx context.Context, slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error)
+}
+
+// NotesService covers https://www.reamaze.com/api/get_notes endpoints
+type NotesService interface {
+	GetNotes(identifier string) (*GetNotesResponse, error)
+	GetNotesWithContext(ctx context.Context, identifier string) (*GetNotesResponse, error)
+	CreateNote(identifier string, req *CreateNoteRequest) (*CreateNoteResponse, error)
+	CreateNoteWithContext(ctx context.Context, identifier string, req *CreateNoteRequest) (*CreateNoteResponse, error)
+	UpdateNote(identifier string, noteID string, req *UpdateNoteRequest) (*UpdateNoteResponse, error)
+	UpdateNoteWithContext(ctx context.Context, identifier string, noteID string, req *UpdateNoteRequest) (*UpdateNoteResponse, error)
+	DeleteNote(identifier string, noteID string) (*DeleteNoteResponse, error)
+	DeleteNoteWithContext(ctx context.Context, identifier string, noteID string) (*DeleteNoteResponse, error)
+}
+
+// ReportsService covers https://www.reamaze.com/api/get_reports_volume endpoints
+type ReportsService interface {
+	GetReportsVolume(o ...ReportsOption) (*GetReportsVolumeResponse, error)
+	GetReportsVolumeWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsVolumeResponse, error)
+	GetReportsResponseTime(o ...ReportsOption) (*GetReportsResponseTimeRespone, error)
+	GetReportsResponseTimeWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsResponseTimeRespone, error)
+	GetReportsStaff(o ...ReportsOption) (*GetReportsStaffResponse, error)
+	GetReportsStaffWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsStaffResponse, error)
+	GetReportsTags(o ...ReportsOption) (*GetReportsTagsResponse, error)
+	GetReportsTagsWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsTagsResponse, error)
+	GetReportsChannelSummary(o ...ReportsOption) (*GetReportsChannelSummaryResponse, error)
+	GetReportsChannelSummaryWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsChannelSummaryResponse, error)
+}
+
+// ResponseTemplatesService covers https://www.reamaze.com/api/get_response_templates endpoints
+type ResponseTemplatesService interface {
+	GetResponseTemplates() (*GetResponseTemplatesResponse, error)
+	GetResponseTemplatesWithContext(ctx context.Context) (*GetResponseTemplatesResponse, error)
+	GetResponseTemplate(identifier string) (*GetResponseTemplateResponse, error)
+	GetResponseTemplateWithContext(ctx context.Context, identifier string) (*GetResponseTemplateResponse, error)
+	CreateResponseTemplate(req *CreateResponseTemplateRequest) (*CreateResponseTemplateResponse, error)
+	CreateResponseTemplateWithContext(ctx context.Context, req *CreateResponseTemplateRequest) (*CreateResponseTemplateResponse, error)
+	UpdateResponseTemplate(identifier string, req *UpdateResponseTemplateRequest) (*UpdateResponseTemplateResponse, error)
+	UpdateResponseTemplateWithContext(ctx context.Context, identifier string, req *UpdateResponseTemplateRequest) (*UpdateResponseTemplateResponse, error)
+}
+
+// StaffService covers https://www.reamaze.com/api/get_staff endpoints
+type StaffService interface {
+	GetStaff(o ...StaffOption) (*GetStaffResponse, error)
+	GetStaffWithContext(ctx context.Context, o ...StaffOption) (*GetStaffResponse, error)
+	CreateStaff(req *CreateStaffRequest) (*CreateStaffResponse, error)
+	CreateStaffWithContext(ctx context.Context, req *CreateStaffRequest) (*CreateStaffResponse, error)
+}
+
+// SystemsService covers https://www.reamaze.com/api/get_systems endpoint
+type SystemsService interface {
+	GetSystems() (*GetSystemsResponse, error)
+	GetSystemsWithContext(ctx context.Context) (*GetSystemsResponse, error)
+}
+
+// making sure Client implements API
+var _ API = (*Client)(nil)
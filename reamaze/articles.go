@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -12,11 +13,19 @@ import (
 // optional parameters WithArticlePage(int), WithArticleStatus(ReamazeArticleStatus),WithArticleQuery(string)
 // https://www.reamaze.com/api/get_articles
 func (c *Client) GetArticles(o ...ArticlesOption) (*GetArticlesResponse, error) {
+	return c.GetArticlesWithContext(context.Background(), o...)
+}
+
+// GetArticlesWithContext is like GetArticles but uses ctx for the underlying request.
+func (c *Client) GetArticlesWithContext(ctx context.Context, o ...ArticlesOption) (*GetArticlesResponse, error) {
 	var response *GetArticlesResponse
-	settings, _ := newArticlesSettings(o)
+	settings, err := newArticlesSettings(o)
+	if err != nil {
+		return nil, err
+	}
 	urlEndpoint := articlesEndpoint + settings.GetQuery()
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -31,13 +40,18 @@ func (c *Client) GetArticles(o ...ArticlesOption) (*GetArticlesResponse, error)
 // GetArticle will allow you to retrieve a specific Help Article
 // https://www.reamaze.com/api/get_article
 func (c *Client) GetArticle(slug string) (*GetArticleResponse, error) {
+	return c.GetArticleWithContext(context.Background(), slug)
+}
+
+// GetArticleWithContext is like GetArticle but uses ctx for the underlying request.
+func (c *Client) GetArticleWithContext(ctx context.Context, slug string) (*GetArticleResponse, error) {
 	var response *GetArticleResponse
 	// checking if slug is set
 	if len(slug) == 0 {
 		return nil, errors.New("GetArticle slug cannot be empty, please provide slug as argument")
 	}
 	urlEndpoint := articlesEndpoint + "/" + url.PathEscape(slug)
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +66,11 @@ func (c *Client) GetArticle(slug string) (*GetArticleResponse, error) {
 // UpdateArticle will allow you to update the article.
 // https://www.reamaze.com/api/put_article
 func (c *Client) UpdateArticle(slug string, req *UpdateArticleRequest) (*UpdateArticleResponse, error) {
+	return c.UpdateArticleWithContext(context.Background(), slug, req)
+}
+
+// UpdateArticleWithContext is like UpdateArticle but uses ctx for the underlying request.
+func (c *Client) UpdateArticleWithContext(ctx context.Context, slug string, req *UpdateArticleRequest) (*UpdateArticleResponse, error) {
 	var response *UpdateArticleResponse
 	emptyReq := &UpdateArticleRequest{}
 	// checking if we don't have empty request
@@ -63,7 +82,7 @@ func (c *Client) UpdateArticle(slug string, req *UpdateArticleRequest) (*UpdateA
 		return nil, errors.New("UpdateArticle slug cannot be empty, please provide slug as argument")
 	}
 	urlEndpoint := articlesEndpoint + "/" + url.PathEscape(slug)
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +97,11 @@ func (c *Client) UpdateArticle(slug string, req *UpdateArticleRequest) (*UpdateA
 // CreateArticle will allow you to update the article.
 // https://www.reamaze.com/api/put_article
 func (c *Client) CreateArticle(req *CreateArticleRequest) (*CreateArticleResponse, error) {
+	return c.CreateArticleWithContext(context.Background(), req)
+}
+
+// CreateArticleWithContext is like CreateArticle but uses ctx for the underlying request.
+func (c *Client) CreateArticleWithContext(ctx context.Context, req *CreateArticleRequest) (*CreateArticleResponse, error) {
 	var response *CreateArticleResponse
 	emptyReq := &CreateArticleRequest{}
 	// checking if we don't have empty request
@@ -87,7 +111,7 @@ func (c *Client) CreateArticle(req *CreateArticleRequest) (*CreateArticleRespons
 
 	urlEndpoint := articlesEndpoint
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
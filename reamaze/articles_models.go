@@ -1,9 +1,8 @@
 package reamaze
 
 import (
-	"net/url"
+	"fmt"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -24,20 +23,21 @@ type ArticlesOption interface {
 }
 
 func (w ReamazeArticleStatus) Apply(o *ReamazeArticlesOptions) {
-	if w > 0 {
-		o.ReamazeArticlesStatus = "status=" + strconv.Itoa(int(w))
+	switch {
+	case w < ReamazeArticleStatusPublished || w > ReamazeArticleStatusInternal:
+		o.invalid(fmt.Errorf("WithArticleStatus status %d is unknown, please provide one of ReamazeArticleStatus constants", int(w)))
+	case w > ReamazeArticleStatusPublished:
+		o.set("status", strconv.Itoa(int(w)))
 	}
 }
 
 func (w ReamazeArticlePage) Apply(o *ReamazeArticlesOptions) {
-	if w > 0 {
-		o.ReamazeArticlesPage = "page=" + strconv.Itoa(int(w))
-	}
+	o.setPage("WithArticlePage", int(w))
 }
 
 func (w ReamazeArticleQuery) Apply(o *ReamazeArticlesOptions) {
 	if len(w) > 0 {
-		o.ReamazeArticlesQuery = "q=" + url.PathEscape(string(w))
+		o.set("q", string(w))
 	}
 }
 
@@ -58,90 +58,33 @@ func newArticlesSettings(opts []ArticlesOption) (*ReamazeArticlesOptions, error)
 	for _, opt := range opts {
 		opt.Apply(&o)
 	}
-	return &o, nil
-}
-
-func (r ReamazeArticlesOptions) GetQuery() string {
-	output := ""
-	var queryParams []string
-
-	// checking if status is set
-	if len(r.ReamazeArticlesStatus) > 0 {
-		queryParams = append(queryParams, r.ReamazeArticlesStatus)
-	}
-	// checking if query is set
-	if len(r.ReamazeArticlesQuery) > 0 {
-		queryParams = append(queryParams, r.ReamazeArticlesQuery)
+	if err := o.Err(); err != nil {
+		return nil, err
 	}
-	// checking if page is set
-	if len(r.ReamazeArticlesPage) > 0 {
-		queryParams = append(queryParams, r.ReamazeArticlesPage)
-	}
-
-	output = strings.Join(queryParams, "&")
-	if len(output) > 0 {
-		output = "?" + output
-	}
-	return output
+	return &o, nil
 }
 
+// ReamazeArticlesOptions are the query parameters of GetArticles set by ArticlesOption
 type ReamazeArticlesOptions struct {
-	ReamazeArticlesStatus string
-	ReamazeArticlesQuery  string
-	ReamazeArticlesPage   string
+	ReamazeQuery
 }
+
 type GetArticlesResponse struct {
-	PageSize   int `json:"page_size,omitempty"`
-	PageCount  int `json:"page_count,omitempty"`
-	TotalCount int `json:"total_count,omitempty"`
-	Articles   []struct {
-		Title     string    `json:"title,omitempty"`
-		Body      string    `json:"body,omitempty"`
-		Slug      string    `json:"slug,omitempty"`
-		Status    int       `json:"status,omitempty"`
-		CreatedAt time.Time `json:"created_at,omitempty"`
-		UpdatedAt time.Time `json:"updated_at,omitempty"`
-		URL       string    `json:"url,omitempty"`
-		Author    struct {
-			ID           int    `json:"id,omitempty"`
-			Name         string `json:"name,omitempty"`
-			Data         any    `json:"data,omitempty"`
-			Email        string `json:"email,omitempty"`
-			Twitter      string `json:"twitter,omitempty"`
-			Facebook     string `json:"facebook,omitempty"`
-			Instagram    string `json:"instagram,omitempty"`
-			Mobile       string `json:"mobile,omitempty"`
-			FriendlyName string `json:"friendly_name,omitempty"`
-			DisplayName  string `json:"display_name,omitempty"`
-		} `json:"author,omitempty"`
-		EmbeddedURL string `json:"embedded_url,omitempty"`
-		Topic       struct {
-			Name string `json:"name,omitempty"`
-			Slug string `json:"slug,omitempty"`
-		} `json:"topic,omitempty"`
-	} `json:"articles,omitempty"`
+	PageSize   int              `json:"page_size,omitempty"`
+	PageCount  int              `json:"page_count,omitempty"`
+	TotalCount int              `json:"total_count,omitempty"`
+	Articles   []ReamazeArticle `json:"articles,omitempty"`
 }
 type ReamazeArticle struct {
-	Title     string    `json:"title,omitempty"`
-	Body      string    `json:"body,omitempty"`
-	Slug      string    `json:"slug,omitempty"`
-	Status    int       `json:"status,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	URL       string    `json:"url,omitempty"`
-	Author    struct {
-		ID           int    `json:"id,omitempty"`
-		Name         string `json:"name,omitempty"`
-		Data         any    `json:"data,omitempty"`
-		Email        string `json:"email,omitempty"`
-		Twitter      string `json:"twitter,omitempty"`
-		Facebook     string `json:"facebook,omitempty"`
-		Instagram    string `json:"instagram,omitempty"`
-		Mobile       string `json:"mobile,omitempty"`
-		FriendlyName string `json:"friendly_name,omitempty"`
-		DisplayName  string `json:"display_name,omitempty"`
-	} `json:"author,omitempty"`
-	EmbeddedURL string `json:"embedded_url,omitempty"`
+	Title       string    `json:"title,omitempty"`
+	Body        string    `json:"body,omitempty"`
+	Slug        string    `json:"slug,omitempty"`
+	Status      int       `json:"status,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	Author      User      `json:"author,omitempty"`
+	EmbeddedURL string    `json:"embedded_url,omitempty"`
 	Topic       struct {
 		Name string `json:"name,omitempty"`
 		Slug string `json:"slug,omitempty"`
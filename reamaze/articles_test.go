@@ -413,37 +413,26 @@ func TestClient_CreateArticle(t *testing.T) {
 }
 
 func TestReamazeArticlesOptions_GetQuery(t *testing.T) {
-	type fields struct {
-		ReamazeArticlesStatus string
-		ReamazeArticlesQuery  string
-		ReamazeArticlesPage   string
-	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   string
+		name string
+		opts []ArticlesOption
+		want string
 	}{
 		{
-			name:   "Testing ReamazeArticlesOptions.GetQuery no fields set",
-			fields: fields{},
-			want:   "",
+			name: "Testing ReamazeArticlesOptions.GetQuery no options set",
+			want: "",
 		},
 		{
-			name: "Testing ReamazeArticlesOptions.GetQuery with fields set",
-			fields: fields{
-				ReamazeArticlesQuery:  "q=dummy",
-				ReamazeArticlesPage:   "page=1",
-				ReamazeArticlesStatus: "status=" + strconv.Itoa(int(ReamazeArticleStatusPublished)),
-			},
-			want: "?status=" + strconv.Itoa(int(ReamazeArticleStatusPublished)) + "&q=dummy&page=1",
+			name: "Testing ReamazeArticlesOptions.GetQuery with options set",
+			opts: []ArticlesOption{WithArticleStatus(ReamazeArticleStatusDraft), WithArticleQuery("dummy query"), WithArticlePage(1)},
+			want: "?page=1&q=dummy+query&status=" + strconv.Itoa(int(ReamazeArticleStatusDraft)),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := ReamazeArticlesOptions{
-				ReamazeArticlesStatus: tt.fields.ReamazeArticlesStatus,
-				ReamazeArticlesQuery:  tt.fields.ReamazeArticlesQuery,
-				ReamazeArticlesPage:   tt.fields.ReamazeArticlesPage,
+			var r ReamazeArticlesOptions
+			for _, opt := range tt.opts {
+				opt.Apply(&r)
 			}
 			if got := r.GetQuery(); got != tt.want {
 				t.Errorf("ReamazeArticlesOptions.GetQuery() = %v, want %v", got, tt.want)
@@ -459,21 +448,26 @@ func Test_newArticlesSettings(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    *ReamazeArticlesOptions
+		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Testing newArticlesSettings expansion when no arguments provided",
 			args:    args{},
-			want:    &ReamazeArticlesOptions{},
+			want:    "",
 			wantErr: false,
 		},
 		{
 			name:    "Testing newArticlesSettings WithArticleStatus with ReamazeArticleStatusPublished",
 			args:    args{opts: []ArticlesOption{WithArticleStatus(ReamazeArticleStatusDraft)}},
-			want:    &ReamazeArticlesOptions{ReamazeArticlesStatus: "status=1"},
+			want:    "?status=1",
 			wantErr: false,
 		},
+		{
+			name:    "Testing newArticlesSettings WithArticleStatus with unknown status",
+			args:    args{opts: []ArticlesOption{WithArticleStatus(ReamazeArticleStatus(7))}},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -482,8 +476,8 @@ func Test_newArticlesSettings(t *testing.T) {
 				t.Errorf("newArticlesSettings() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("newArticlesSettings() = %v, want %v", got, tt.want)
+			if err == nil && got.GetQuery() != tt.want {
+				t.Errorf("newArticlesSettings() = %v, want %v", got.GetQuery(), tt.want)
 			}
 		})
 	}
@@ -545,26 +539,26 @@ func TestReamazeArticlePage_Apply(t *testing.T) {
 		name string
 		w    ReamazeArticlePage
 		args args
-		want *ReamazeArticlesOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeArticlesPage is not being set when 0",
 			w:    ReamazeArticlePage(0),
 			args: args{o: &ReamazeArticlesOptions{}},
-			want: &ReamazeArticlesOptions{},
+			want: "",
 		},
 		{
 			name: "Testing if ReamazeArticlePage is being set",
 			w:    ReamazeArticlePage(1),
 			args: args{o: &ReamazeArticlesOptions{}},
-			want: &ReamazeArticlesOptions{ReamazeArticlesPage: "page=1"},
+			want: "?page=1",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeArticlePage.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -579,26 +573,26 @@ func TestReamazeArticleQuery_Apply(t *testing.T) {
 		name string
 		w    ReamazeArticleQuery
 		args args
-		want *ReamazeArticlesOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeArticlesQuery is being set",
 			w:    ReamazeArticleQuery("dummy"),
 			args: args{o: &ReamazeArticlesOptions{}},
-			want: &ReamazeArticlesOptions{ReamazeArticlesQuery: "q=dummy"},
+			want: "?q=dummy",
 		},
 		{
 			name: "Testing if ReamazeArticleQuery is not being set when empty",
 			w:    ReamazeArticleQuery(""),
 			args: args{o: &ReamazeArticlesOptions{}},
-			want: &ReamazeArticlesOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeArticleQuery.Apply() = %v, want %v", got, tt.want)
 			}
 		})
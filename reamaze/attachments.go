@@ -0,0 +1,212 @@
+package reamaze
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultMaxAttachmentSize is the size limit of attachments encoded by NewAttachment when WithMaxAttachmentSize isn't used
+const DefaultMaxAttachmentSize int64 = 10 << 20
+
+// ErrAttachmentTooLarge is returned when the attachment exceeds the size limit
+var ErrAttachmentTooLarge = errors.New("reamaze: attachment too large")
+
+type ReamazeAttachmentMaxSize int64
+
+type AttachmentOption interface {
+	Apply(*ReamazeAttachmentOptions)
+}
+
+type ReamazeAttachmentOptions struct {
+	MaxSize int64
+}
+
+func (w ReamazeAttachmentMaxSize) Apply(o *ReamazeAttachmentOptions) {
+	if w > 0 {
+		o.MaxSize = int64(w)
+	}
+}
+
+// WithMaxAttachmentSize sets the size limit in bytes of the encoded or downloaded attachment
+func WithMaxAttachmentSize(size int64) ReamazeAttachmentMaxSize {
+	return ReamazeAttachmentMaxSize(size)
+}
+
+func newAttachmentSettings(opts []AttachmentOption) *ReamazeAttachmentOptions {
+	var o ReamazeAttachmentOptions
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	return &o
+}
+
+// NewAttachment reads r and returns it encoded as base64 data URI, the form re:amaze expects in
+// MessageRequest.Attachments and ConversationMessage.Attachments, e.g. data:image/png;name=logo.png;base64,iVBORw0KGgo=
+//
+// When contentType is empty it's guessed from the filename extension, or from the content.
+// Attachments larger than DefaultMaxAttachmentSize are rejected with ErrAttachmentTooLarge, use WithMaxAttachmentSize to change the limit.
+func NewAttachment(r io.Reader, filename, contentType string, o ...AttachmentOption) (string, error) {
+	settings := newAttachmentSettings(o)
+	if settings.MaxSize == 0 {
+		settings.MaxSize = DefaultMaxAttachmentSize
+	}
+	// reading one byte more than the limit to find out if it has been exceeded
+	data, err := io.ReadAll(io.LimitReader(r, settings.MaxSize+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) > settings.MaxSize {
+		return "", fmt.Errorf("%w: %s exceeds %d bytes", ErrAttachmentTooLarge, filename, settings.MaxSize)
+	}
+	if len(contentType) == 0 {
+		contentType = mime.TypeByExtension(filepath.Ext(filename))
+	}
+	if len(contentType) == 0 {
+		contentType = http.DetectContentType(data)
+	}
+	// parameters such as charset are dropped, they can't be told apart from the name parameter
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("NewAttachment incorrect content type %q: %w", contentType, err)
+	}
+
+	uri := "data:" + mediaType
+	if len(filename) > 0 {
+		uri += ";name=" + url.PathEscape(filepath.Base(filename))
+	}
+	return uri + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// NewAttachmentFromFile is like NewAttachment but reads the file at path, named after its base name
+func NewAttachmentFromFile(path string, o ...AttachmentOption) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return NewAttachment(f, filepath.Base(path), "", o...)
+}
+
+// Attach adds r as attachment of the message, see NewAttachment
+func (m *MessageRequest) Attach(r io.Reader, filename, contentType string, o ...AttachmentOption) error {
+	attachment, err := NewAttachment(r, filename, contentType, o...)
+	if err != nil {
+		return err
+	}
+	m.Attachments = append(m.Attachments, attachment)
+	return nil
+}
+
+// AttachFile adds file at path as attachment of the message, see NewAttachmentFromFile
+func (m *MessageRequest) AttachFile(path string, o ...AttachmentOption) error {
+	attachment, err := NewAttachmentFromFile(path, o...)
+	if err != nil {
+		return err
+	}
+	m.Attachments = append(m.Attachments, attachment)
+	return nil
+}
+
+// Attach adds r as attachment of the first message of the conversation, see NewAttachment
+func (m *ConversationMessage) Attach(r io.Reader, filename, contentType string, o ...AttachmentOption) error {
+	attachment, err := NewAttachment(r, filename, contentType, o...)
+	if err != nil {
+		return err
+	}
+	m.Attachments = append(m.Attachments, attachment)
+	return nil
+}
+
+// AttachFile adds file at path as attachment of the first message of the conversation, see NewAttachmentFromFile
+func (m *ConversationMessage) AttachFile(path string, o ...AttachmentOption) error {
+	attachment, err := NewAttachmentFromFile(path, o...)
+	if err != nil {
+		return err
+	}
+	m.Attachments = append(m.Attachments, attachment)
+	return nil
+}
+
+// DownloadAttachment writes the file of the attachment e.g. one of GetMessagesResponse.Messages[].Attachments to w,
+// and returns the number of bytes written. The size isn't limited unless WithMaxAttachmentSize is used.
+// Downloads from re:amaze wait for the rate limit like API requests, but they aren't retried as the file is streamed to w.
+func (c *Client) DownloadAttachment(attachment Attachment, w io.Writer, o ...AttachmentOption) (int64, error) {
+	return c.DownloadAttachmentWithContext(context.Background(), attachment, w, o...)
+}
+
+// DownloadAttachmentWithContext is like DownloadAttachment but uses ctx for the underlying request.
+func (c *Client) DownloadAttachmentWithContext(ctx context.Context, attachment Attachment, w io.Writer, o ...AttachmentOption) (int64, error) {
+	settings := newAttachmentSettings(o)
+	// checking if we have URL set
+	if len(attachment.URL) == 0 {
+		return 0, errors.New("DownloadAttachment attachment URL cannot be empty, please provide attachment with URL as argument")
+	}
+	attachmentURL := attachment.URL
+	if strings.HasPrefix(attachmentURL, "/") {
+		attachmentURL = c.baseURL + attachmentURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachmentURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	// credentials are sent only to re:amaze, attachments are usually served from a storage with signed URLs
+	if sameHost(attachmentURL, c.baseURL) {
+		// downloads from re:amaze count towards the same limit as API requests
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return 0, err
+			}
+		}
+		req.Header.Add("Authorization", "Basic "+c.auth)
+	}
+	if len(c.userAgent) > 0 {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// Checking if we have response status code within acceptable numbers 200-299
+	if res.StatusCode >= 300 || res.StatusCode < 200 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 64<<10))
+		return 0, newAPIError(http.MethodGet, attachment.URL, res, body)
+	}
+	if settings.MaxSize == 0 {
+		return io.Copy(w, res.Body)
+	}
+	if res.ContentLength > settings.MaxSize {
+		return 0, fmt.Errorf("%w: %s exceeds %d bytes", ErrAttachmentTooLarge, attachment.URL, settings.MaxSize)
+	}
+	n, err := io.Copy(w, io.LimitReader(res.Body, settings.MaxSize))
+	if err != nil {
+		return n, err
+	}
+	// checking if there is anything left over the limit
+	if extra, _ := io.CopyN(io.Discard, res.Body, 1); extra > 0 {
+		return n, fmt.Errorf("%w: %s exceeds %d bytes", ErrAttachmentTooLarge, attachment.URL, settings.MaxSize)
+	}
+	return n, nil
+}
+
+// sameHost checks if both URLs point at the same scheme and host
+func sameHost(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return ua.Scheme == ub.Scheme && strings.EqualFold(ua.Host, ub.Host)
+}
@@ -0,0 +1,217 @@
+package reamaze
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAttachment(t *testing.T) {
+	type args struct {
+		r           io.Reader
+		filename    string
+		contentType string
+		opts        []AttachmentOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Testing content type from argument",
+			args: args{r: strings.NewReader("hello"), filename: "hello.bin", contentType: "text/plain"},
+			want: "data:text/plain;name=hello.bin;base64,aGVsbG8=",
+		},
+		{
+			name: "Testing content type from extension without parameters",
+			args: args{r: strings.NewReader("{}"), filename: "dir/hello world.json"},
+			want: "data:application/json;name=hello%20world.json;base64,e30=",
+		},
+		{
+			name: "Testing content type detected from content",
+			args: args{r: bytes.NewReader([]byte("\x89PNG\x0D\x0A\x1A\x0A")), filename: ""},
+			want: "data:image/png;base64,iVBORw0KGgo=",
+		},
+		{
+			name:    "Testing attachment exceeding the limit",
+			args:    args{r: strings.NewReader("hello"), filename: "hello.txt", opts: []AttachmentOption{WithMaxAttachmentSize(4)}},
+			wantErr: ErrAttachmentTooLarge,
+		},
+		{
+			name: "Testing attachment at the limit",
+			args: args{r: strings.NewReader("hello"), filename: "hello.txt", opts: []AttachmentOption{WithMaxAttachmentSize(5)}},
+			want: "data:text/plain;name=hello.txt;base64,aGVsbG8=",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAttachment(tt.args.r, tt.args.filename, tt.args.contentType, tt.args.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewAttachment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttachFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	var message MessageRequest
+	if err := message.AttachFile(path); err != nil {
+		t.Fatalf("MessageRequest.AttachFile() error = %v", err)
+	}
+	if err := message.Attach(strings.NewReader("hi"), "hi.txt", ""); err != nil {
+		t.Fatalf("MessageRequest.Attach() error = %v", err)
+	}
+	want := []string{"data:application/pdf;name=report.pdf;base64,JVBERi0=", "data:text/plain;name=hi.txt;base64,aGk="}
+	if len(message.Attachments) != 2 || message.Attachments[0] != want[0] || message.Attachments[1] != want[1] {
+		t.Errorf("MessageRequest.Attachments = %v, want %v", message.Attachments, want)
+	}
+
+	var conversation ConversationMessage
+	if err := conversation.AttachFile(filepath.Join(t.TempDir(), "dummy")); err == nil {
+		t.Errorf("ConversationMessage.AttachFile() expected error for missing file")
+	}
+	if err := conversation.Attach(strings.NewReader("hello"), "hello.txt", "", WithMaxAttachmentSize(1)); !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("ConversationMessage.Attach() error = %v, want ErrAttachmentTooLarge", err)
+	}
+	if len(conversation.Attachments) != 0 {
+		t.Errorf("ConversationMessage.Attachments = %v, want none", conversation.Attachments)
+	}
+}
+
+func TestClient_DownloadAttachment(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		status     int
+		opts       []AttachmentOption
+		want       string
+		wantAuth   bool
+		wantURL    string
+		wantErr    bool
+		wantErrIs  error
+		noResponse bool
+	}{
+		{
+			name:    "Testing download from storage without credentials",
+			url:     "https://storage.example.com/file.txt?signature=abc",
+			status:  http.StatusOK,
+			want:    "hello world",
+			wantURL: "https://storage.example.com/file.txt?signature=abc",
+		},
+		{
+			name:     "Testing download from re:amaze with credentials",
+			url:      "/attachments/1/file.txt",
+			status:   http.StatusOK,
+			want:     "hello world",
+			wantAuth: true,
+			wantURL:  "https://dummy.reamaze.io/attachments/1/file.txt",
+		},
+		{
+			name:      "Testing attachment exceeding the limit",
+			url:       "https://storage.example.com/file.txt",
+			status:    http.StatusOK,
+			opts:      []AttachmentOption{WithMaxAttachmentSize(5)},
+			wantErr:   true,
+			wantErrIs: ErrAttachmentTooLarge,
+			wantURL:   "https://storage.example.com/file.txt",
+		},
+		{
+			name:      "Testing not found attachment",
+			url:       "https://storage.example.com/file.txt",
+			status:    http.StatusNotFound,
+			wantErr:   true,
+			wantErrIs: ErrNotFound,
+			wantURL:   "https://storage.example.com/file.txt",
+		},
+		{
+			name:       "Testing empty URL",
+			url:        "",
+			wantErr:    true,
+			noResponse: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotReq *http.Request
+			c := &Client{
+				baseURL: "https://dummy.reamaze.io",
+				auth:    "dummy",
+				httpClient: &http.Client{
+					Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+						gotReq = req
+						return &http.Response{
+							StatusCode: tt.status,
+							Status:     http.StatusText(tt.status),
+							Body:       io.NopCloser(strings.NewReader("hello world")),
+							Header:     http.Header{},
+						}
+					}),
+				},
+			}
+			var out bytes.Buffer
+			n, err := c.DownloadAttachment(Attachment{URL: tt.url}, &out, tt.opts...)
+			if (err != nil) != tt.wantErr || (tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs)) {
+				t.Fatalf("Client.DownloadAttachment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.noResponse {
+				return
+			}
+			if gotReq.URL.String() != tt.wantURL {
+				t.Errorf("Client.DownloadAttachment() requested %v, want %v", gotReq.URL, tt.wantURL)
+			}
+			if hasAuth := len(gotReq.Header.Get("Authorization")) > 0; hasAuth != tt.wantAuth {
+				t.Errorf("Client.DownloadAttachment() sent credentials = %v, want %v", hasAuth, tt.wantAuth)
+			}
+			if !tt.wantErr && (out.String() != tt.want || n != int64(len(tt.want))) {
+				t.Errorf("Client.DownloadAttachment() = %d %q, want %q", n, out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadAttachmentRateLimit(t *testing.T) {
+	var requested []string
+	c := &Client{
+		baseURL:     "https://dummy.reamaze.io",
+		auth:        "dummy",
+		rateLimiter: newRateLimiter(1, 1),
+		httpClient: &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				requested = append(requested, req.URL.String())
+				return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader("hello world"))}
+			}),
+		},
+	}
+	// using up the only token, the next one is available in a second
+	c.rateLimiter.reserve()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.DownloadAttachmentWithContext(ctx, Attachment{URL: "/attachments/1/file.txt"}, io.Discard); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Client.DownloadAttachmentWithContext() from re:amaze error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	// storage downloads don't use the API budget
+	if _, err := c.DownloadAttachmentWithContext(context.Background(), Attachment{URL: "https://storage.example.com/file.txt"}, io.Discard); err != nil {
+		t.Errorf("Client.DownloadAttachmentWithContext() from storage error = %v", err)
+	}
+	if want := []string{"https://storage.example.com/file.txt"}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("requests = %v, want %v", requested, want)
+	}
+}
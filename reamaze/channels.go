@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -9,10 +10,15 @@ import (
 
 // GetChannels will allow you to retrieve channels for the Brand https://www.reamaze.com/api/get_channels
 func (c *Client) GetChannels() (*GetChannelsResponse, error) {
+	return c.GetChannelsWithContext(context.Background())
+}
+
+// GetChannelsWithContext is like GetChannels but uses ctx for the underlying request.
+func (c *Client) GetChannelsWithContext(ctx context.Context) (*GetChannelsResponse, error) {
 	var response *GetChannelsResponse
 
 	urlEndpoint := channelsEndpoint
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +32,11 @@ func (c *Client) GetChannels() (*GetChannelsResponse, error) {
 
 // GetChannel will allow you to retrieve a specific channel https://www.reamaze.com/api/get_channel
 func (c *Client) GetChannel(slug string) (*GetChannelResponse, error) {
+	return c.GetChannelWithContext(context.Background(), slug)
+}
+
+// GetChannelWithContext is like GetChannel but uses ctx for the underlying request.
+func (c *Client) GetChannelWithContext(ctx context.Context, slug string) (*GetChannelResponse, error) {
 	var response *GetChannelResponse
 	// checking if slug is set
 	if len(slug) == 0 {
@@ -33,7 +44,7 @@ func (c *Client) GetChannel(slug string) (*GetChannelResponse, error) {
 	}
 	urlEndpoint := channelsEndpoint + "/" + url.PathEscape(slug)
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
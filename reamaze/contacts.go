@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -10,11 +11,21 @@ import (
 
 // GetContacts retrieves all contacts for the Account
 // Note that unlike other resources, contacts are tied to the account, not the individual brand.
-// TODO: adding params q,data,sort,type,page
-func (c *Client) GetContacts() (*GetContactsResponse, error) {
+// optional parameters WithContactsQuery(string), WithContactsType(ReamazeIdentifier), WithContactsPage(int)
+// https://www.reamaze.com/api/get_contacts
+func (c *Client) GetContacts(o ...ContactsOption) (*GetContactsResponse, error) {
+	return c.GetContactsWithContext(context.Background(), o...)
+}
+
+// GetContactsWithContext is like GetContacts but uses ctx for the underlying request.
+func (c *Client) GetContactsWithContext(ctx context.Context, o ...ContactsOption) (*GetContactsResponse, error) {
 	var response *GetContactsResponse
-	urlEndpoint := contactsEndpoint
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	settings, err := newContactsSettings(o)
+	if err != nil {
+		return nil, err
+	}
+	urlEndpoint := contactsEndpoint + settings.GetQuery()
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -28,6 +39,11 @@ func (c *Client) GetContacts() (*GetContactsResponse, error) {
 
 // GetContact getting Contact for identifier
 func (c *Client) GetContact(identifier string) (*GetContactResponse, error) {
+	return c.GetContactWithContext(context.Background(), identifier)
+}
+
+// GetContactWithContext is like GetContact but uses ctx for the underlying request.
+func (c *Client) GetContactWithContext(ctx context.Context, identifier string) (*GetContactResponse, error) {
 	var response *GetContactResponse
 	// checking if identifier is set
 	if len(identifier) == 0 {
@@ -35,7 +51,7 @@ func (c *Client) GetContact(identifier string) (*GetContactResponse, error) {
 	}
 	urlEndpoint := contactsEndpoint + "/" + url.PathEscape(identifier)
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +65,11 @@ func (c *Client) GetContact(identifier string) (*GetContactResponse, error) {
 
 // CreateContact creates new Contact  https://www.reamaze.com/api/post_contacts
 func (c *Client) CreateContact(req *CreateContactRequest) (*GetContactResponse, error) {
+	return c.CreateContactWithContext(context.Background(), req)
+}
+
+// CreateContactWithContext is like CreateContact but uses ctx for the underlying request.
+func (c *Client) CreateContactWithContext(ctx context.Context, req *CreateContactRequest) (*GetContactResponse, error) {
 	var response *GetContactResponse
 	emptyReq := &CreateContactRequest{}
 	// checking if we don't have empty request
@@ -64,7 +85,7 @@ func (c *Client) CreateContact(req *CreateContactRequest) (*GetContactResponse,
 	}
 	urlEndpoint := contactsEndpoint
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +100,11 @@ func (c *Client) CreateContact(req *CreateContactRequest) (*GetContactResponse,
 // UpdateContact update existing Contact  https://www.reamaze.com/api/put_contacts
 // This re:amaze endpoint can only change name, friendly_name, external_avatar_url and data attributes
 func (c *Client) UpdateContact(identifier string, req *UpdateContactRequest, identifierType ...ReamazeIdentifier) (*GetContactResponse, error) {
+	return c.UpdateContactWithContext(context.Background(), identifier, req, identifierType...)
+}
+
+// UpdateContactWithContext is like UpdateContact but uses ctx for the underlying request.
+func (c *Client) UpdateContactWithContext(ctx context.Context, identifier string, req *UpdateContactRequest, identifierType ...ReamazeIdentifier) (*GetContactResponse, error) {
 	// We set default identifier type to email
 	idType := string(ReamazeIdentifierEmail)
 	// If we have explicitly defined identifier type than we set idType to this identifier type
@@ -100,7 +126,7 @@ func (c *Client) UpdateContact(identifier string, req *UpdateContactRequest, ide
 	urlEndpoint := contactsEndpoint + "/" + url.QueryEscape(identifier) + "?identifier_type=" + idType
 	// preparing request
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPut, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPut, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +141,11 @@ func (c *Client) UpdateContact(identifier string, req *UpdateContactRequest, ide
 // GetContactIdentities gets Contact's Identities  https://www.reamaze.com/api/get_identities
 // Identities types are one of 'email', 'twitter','facebook', 'instagram', 'igsid' (Instagram-scoped ID), or 'mobile'.
 func (c *Client) GetContactIdentities(identifier string) (*GetContactIdentitiesResponse, error) {
+	return c.GetContactIdentitiesWithContext(context.Background(), identifier)
+}
+
+// GetContactIdentitiesWithContext is like GetContactIdentities but uses ctx for the underlying request.
+func (c *Client) GetContactIdentitiesWithContext(ctx context.Context, identifier string) (*GetContactIdentitiesResponse, error) {
 	var response *GetContactIdentitiesResponse
 	// checking if identifier is set
 	if len(identifier) == 0 {
@@ -122,7 +153,7 @@ func (c *Client) GetContactIdentities(identifier string) (*GetContactIdentitiesR
 	}
 	urlEndpoint := contactsEndpoint + "/" + url.PathEscape(identifier) + "/identities"
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +168,11 @@ func (c *Client) GetContactIdentities(identifier string) (*GetContactIdentitiesR
 // CreateContactIdentities creates Contact Identities  https://www.reamaze.com/api/post_identities
 // Call to identities will allow you to attach an email, mobile number, twitter handle or instagram user to a contact.
 func (c *Client) CreateContactIdentities(identifier string, req *CreateContactIdentitiesRequest, identifierType ...ReamazeIdentifier) (*GetContactIdentitiesResponse, error) {
+	return c.CreateContactIdentitiesWithContext(context.Background(), identifier, req, identifierType...)
+}
+
+// CreateContactIdentitiesWithContext is like CreateContactIdentities but uses ctx for the underlying request.
+func (c *Client) CreateContactIdentitiesWithContext(ctx context.Context, identifier string, req *CreateContactIdentitiesRequest, identifierType ...ReamazeIdentifier) (*GetContactIdentitiesResponse, error) {
 	// We set default identifier type to email
 	idType := string(ReamazeIdentifierEmail)
 	// If we have explicitly defined identifier type than we set idType to this identifier type
@@ -163,7 +199,7 @@ func (c *Client) CreateContactIdentities(identifier string, req *CreateContactId
 	// preparing request
 	data, _ := json.Marshal(req)
 
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
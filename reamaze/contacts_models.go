@@ -9,6 +9,9 @@ import (
 // Contacts models
 type ReamazePhoneNumber string
 type ReamazeIdentifier string
+type ReamazeContactsQuery string
+type ReamazeContactsType string
+type ReamazeContactsPage int
 
 const contactsEndpoint string = "/api/v1/contacts"
 const (
@@ -19,6 +22,56 @@ const (
 	ReamazeIdentifierInstagram ReamazeIdentifier = "instagram"
 )
 
+type ContactsOption interface {
+	Apply(*ReamazeContactsOptions)
+}
+
+func (w ReamazeContactsQuery) Apply(o *ReamazeContactsOptions) {
+	if len(w) > 0 {
+		o.set("q", string(w))
+	}
+}
+
+func (w ReamazeContactsType) Apply(o *ReamazeContactsOptions) {
+	if len(w) > 0 {
+		o.set("type", string(w))
+	}
+}
+
+func (w ReamazeContactsPage) Apply(o *ReamazeContactsOptions) {
+	o.setPage("WithContactsPage", int(w))
+}
+
+// WithContactsQuery searches contacts by name, email, mobile etc.
+func WithContactsQuery(query string) ReamazeContactsQuery {
+	return ReamazeContactsQuery(query)
+}
+
+// WithContactsType returns only contacts having identity of the given type e.g. ReamazeIdentifierMobile
+func WithContactsType(identifierType ReamazeIdentifier) ReamazeContactsType {
+	return ReamazeContactsType(identifierType)
+}
+
+func WithContactsPage(page int) ReamazeContactsPage {
+	return ReamazeContactsPage(page)
+}
+
+func newContactsSettings(opts []ContactsOption) (*ReamazeContactsOptions, error) {
+	var o ReamazeContactsOptions
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	if err := o.Err(); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ReamazeContactsOptions are the query parameters of GetContacts set by ContactsOption
+type ReamazeContactsOptions struct {
+	ReamazeQuery
+}
+
 func (w ReamazePhoneNumber) Validate() bool {
 	phoneNumber := string(w)
 	e164RegexString := "^\\+[1-9]?[0-9]{7,14}$"
@@ -28,41 +81,14 @@ func (w ReamazePhoneNumber) Validate() bool {
 	return validPhone != nil
 }
 
-type GetContactResponse struct {
-	Name         string      `json:"name"`
-	Data         interface{} `json:"data"`
-	CreatedAt    time.Time   `json:"created_at"`
-	UpdatedAt    time.Time   `json:"updated_at"`
-	Email        string      `json:"email"`
-	Twitter      string      `json:"twitter"`
-	Facebook     string      `json:"facebook"`
-	Instagram    string      `json:"instagram"`
-	Mobile       string      `json:"mobile"`
-	FriendlyName string      `json:"friendly_name"`
-	ID           string      `json:"id"`
-	ID0          int         `json:"_id"`
-	Notes        []Note      `json:"notes"`
-}
+// GetContactResponse is the Contact returned by GetContact, CreateContact and UpdateContact
+type GetContactResponse = Contact
 
 type GetContactsResponse struct {
-	PageSize   int `json:"page_size"`
-	PageCount  int `json:"page_count"`
-	TotalCount int `json:"total_count"`
-	Contacts   []struct {
-		Name         string      `json:"name"`
-		Data         interface{} `json:"data"`
-		CreatedAt    time.Time   `json:"created_at"`
-		UpdatedAt    time.Time   `json:"updated_at"`
-		Email        string      `json:"email"`
-		Twitter      string      `json:"twitter"`
-		Facebook     string      `json:"facebook"`
-		Instagram    string      `json:"instagram"`
-		Mobile       string      `json:"mobile"`
-		FriendlyName string      `json:"friendly_name"`
-		ID           int         `json:"_id"`
-		Notes        []Note      `json:"notes"`
-		ID0          string      `json:"id,omitempty"`
-	} `json:"contacts"`
+	PageSize   int       `json:"page_size"`
+	PageCount  int       `json:"page_count"`
+	TotalCount int       `json:"total_count"`
+	Contacts   []Contact `json:"contacts"`
 }
 
 type CreateContactRequest struct {
@@ -100,3 +126,21 @@ type CreateContactIdentitiesRequest struct {
 		Identifier string            `json:"identifier"`
 	} `json:"identity"`
 }
+
+// Contact is a customer of the account, ID is the identifier given when the contact was created
+// and ID0 is the internal ID assigned by re:amaze
+type Contact struct {
+	Name         string      `json:"name"`
+	Data         interface{} `json:"data"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+	Email        string      `json:"email"`
+	Twitter      string      `json:"twitter"`
+	Facebook     string      `json:"facebook"`
+	Instagram    string      `json:"instagram"`
+	Mobile       string      `json:"mobile"`
+	FriendlyName string      `json:"friendly_name"`
+	ID           string      `json:"id"`
+	ID0          int         `json:"_id"`
+	Notes        []Note      `json:"notes"`
+}
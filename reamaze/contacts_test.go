@@ -790,3 +790,93 @@ func TestClient_CreateContactIdentities(t *testing.T) {
 		})
 	}
 }
+
+func TestReamazeContactsOptions_GetQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ContactsOption
+		want string
+	}{
+		{
+			name: "Testing ReamazeContactsOptions.GetQuery no options set",
+			want: "",
+		},
+		{
+			name: "Testing ReamazeContactsOptions.GetQuery with options set",
+			opts: []ContactsOption{WithContactsQuery("john doe"), WithContactsType(ReamazeIdentifierEmail), WithContactsPage(2)},
+			want: "?page=2&q=john+doe&type=email",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r ReamazeContactsOptions
+			for _, opt := range tt.opts {
+				opt.Apply(&r)
+			}
+			if got := r.GetQuery(); got != tt.want {
+				t.Errorf("ReamazeContactsOptions.GetQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newContactsSettings(t *testing.T) {
+	type args struct {
+		opts []ContactsOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Testing newContactsSettings expansion when no arguments provided",
+			args:    args{},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newContactsSettings with all options",
+			args:    args{opts: []ContactsOption{WithContactsQuery("john doe"), WithContactsType(ReamazeIdentifierEmail), WithContactsPage(3)}},
+			want:    "?page=3&q=john+doe&type=email",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newContactsSettings ignores empty values",
+			args:    args{opts: []ContactsOption{WithContactsQuery(""), WithContactsType(""), WithContactsPage(0)}},
+			want:    "",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newContactsSettings(tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newContactsSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.GetQuery() != tt.want {
+				t.Errorf("newContactsSettings() = %v, want %v", got.GetQuery(), tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetContactsQuery(t *testing.T) {
+	c := &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			if req.URL.RawQuery != "page=2&q=dummy%40example.com" {
+				t.Errorf("Client.GetContacts() query = %v, want %v", req.URL.RawQuery, "page=2&q=dummy%40example.com")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 Status OK",
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}
+		}),
+	}}
+	if _, err := c.GetContacts(WithContactsQuery("dummy@example.com"), WithContactsPage(2)); err != nil {
+		t.Errorf("Client.GetContacts() error = %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -10,6 +11,11 @@ import (
 
 // CreateConversation is creating new conversation in reamaze
 func (c *Client) CreateConversation(req *CreateConversationRequest) (*CreateConversationResponse, error) {
+	return c.CreateConversationWithContext(context.Background(), req)
+}
+
+// CreateConversationWithContext is like CreateConversation but uses ctx for the underlying request.
+func (c *Client) CreateConversationWithContext(ctx context.Context, req *CreateConversationRequest) (*CreateConversationResponse, error) {
 	var response *CreateConversationResponse
 	// Checking if we have all required fields the rest is optional
 	if len(req.Conversation.Category) == 0 || len(req.Conversation.Message.Body) == 0 || len(req.Conversation.User.Email) == 0 {
@@ -17,7 +23,7 @@ func (c *Client) CreateConversation(req *CreateConversationRequest) (*CreateConv
 	}
 
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPost, conversationsEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, conversationsEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -31,6 +37,11 @@ func (c *Client) CreateConversation(req *CreateConversationRequest) (*CreateConv
 
 // UpdateConversation updates a conversation with tags_list,assignee,status,category or brand
 func (c *Client) UpdateConversation(slug string, req *UpdateConversationRequest) (*GetConversationResponse, error) {
+	return c.UpdateConversationWithContext(context.Background(), slug, req)
+}
+
+// UpdateConversationWithContext is like UpdateConversation but uses ctx for the underlying request.
+func (c *Client) UpdateConversationWithContext(ctx context.Context, slug string, req *UpdateConversationRequest) (*GetConversationResponse, error) {
 	var response *GetConversationResponse
 	emptyReq := &UpdateConversationRequest{}
 	// checking if we don't have empty request
@@ -44,7 +55,7 @@ func (c *Client) UpdateConversation(slug string, req *UpdateConversationRequest)
 
 	data, _ := json.Marshal(req)
 	urlEndpoint := conversationsEndpoint + "/" + url.QueryEscape(slug)
-	resp, err := c.reamazeRequest(http.MethodPut, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPut, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -59,10 +70,18 @@ func (c *Client) UpdateConversation(slug string, req *UpdateConversationRequest)
 
 // GetConversations retrieve conversations for the Brand
 func (c *Client) GetConversations(o ...ConversationsOption) (*GetConversationsResponse, error) {
+	return c.GetConversationsWithContext(context.Background(), o...)
+}
+
+// GetConversationsWithContext is like GetConversations but uses ctx for the underlying request.
+func (c *Client) GetConversationsWithContext(ctx context.Context, o ...ConversationsOption) (*GetConversationsResponse, error) {
 	var response *GetConversationsResponse
-	settings, _ := newSettings(o)
+	settings, err := newSettings(o)
+	if err != nil {
+		return nil, err
+	}
 	urlEndpoint := conversationsEndpoint + settings.GetQuery()
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -75,12 +94,17 @@ func (c *Client) GetConversations(o ...ConversationsOption) (*GetConversationsRe
 
 // GetConversation retrieve a specific conversation
 func (c *Client) GetConversation(slug string) (*GetConversationResponse, error) {
+	return c.GetConversationWithContext(context.Background(), slug)
+}
+
+// GetConversationWithContext is like GetConversation but uses ctx for the underlying request.
+func (c *Client) GetConversationWithContext(ctx context.Context, slug string) (*GetConversationResponse, error) {
 	var response *GetConversationResponse
 	if len(slug) == 0 {
 		return nil, errors.New("slug parameter cannot be empty")
 	}
 	urlEndpoint := conversationsEndpoint + "/" + url.QueryEscape(slug)
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -1,15 +1,15 @@
 package reamaze
 
 import (
+	"errors"
 	"fmt"
 	"net/mail"
-	"net/url"
-	"strconv"
 	"strings"
 	"time"
 )
 
 type ReamazeStatus int
+type ReamazeOrigin int
 type ReamazeFilter string
 type ReamazeFor string
 type ReamazeForID string
@@ -39,67 +39,64 @@ type ConversationsOption interface {
 
 func (w ReamazeFilter) Apply(o *ReamazeOptions) {
 	if len(w) > 0 {
-		o.ReamazeFilter = "filter=" + url.QueryEscape(string(w))
+		o.set("filter", string(w))
 	}
 }
 func (w ReamazeFor) Apply(o *ReamazeOptions) {
-	_, err := mail.ParseAddress(string(w))
-	if err == nil {
-		o.ReamazeFor = "for=" + url.QueryEscape(string(w))
+	if len(w) == 0 {
+		return
 	}
+	// checking if we have valid email
+	if _, err := mail.ParseAddress(string(w)); err != nil {
+		o.invalid(fmt.Errorf("WithFor email %q is invalid, please provide valid email address as argument", string(w)))
+		return
+	}
+	o.set("for", string(w))
 }
 func (w ReamazeForID) Apply(o *ReamazeOptions) {
 	if len(w) > 0 {
-		o.ReamazeForID = "for_id=" + url.QueryEscape(string(w))
+		o.set("for_id", string(w))
 	}
 }
 
 func (w ReamazeSort) Apply(o *ReamazeOptions) {
 	if len(w) > 0 {
-		o.ReamazeSort = "sort=" + url.QueryEscape(string(w))
+		o.set("sort", string(w))
 	}
 }
 
 func (w ReamazeData) Apply(o *ReamazeOptions) {
-	if len(w) > 0 {
-		var parts []string
-		for k, v := range w {
-			partsString := "data[" + url.QueryEscape(k) + "]=" + url.QueryEscape(v)
-			parts = append(parts, partsString)
+	for k, v := range w {
+		// checking if we have data key set
+		if len(k) == 0 {
+			o.invalid(errors.New("WithData key cannot be empty, please provide data field name as key"))
+			continue
 		}
-		o.ReamazeData = strings.Join(parts, "&")
+		o.set("data["+k+"]", v)
 	}
 }
 
 func (w ReamazeCategory) Apply(o *ReamazeOptions) {
 	if len(w) > 0 {
-		o.ReamazeCategory = "category=" + url.QueryEscape(string(w))
+		o.set("category", string(w))
 	}
 }
 
 func (w ReamazeEndDate) Apply(o *ReamazeOptions) {
-	date := time.Time(w)
-	if date.Year() > 1 && date.Month() > 0 && date.Day() > 0 {
-		o.ReamazeEndDate = "end_date=" + fmt.Sprintf("%04d", date.Year()) + "-" + fmt.Sprintf("%02d", date.Month()) + "-" + fmt.Sprintf("%02d", date.Day())
-	}
+	o.setDate("end_date", time.Time(w))
 }
 
 func (w ReamazeStartDate) Apply(o *ReamazeOptions) {
-	date := time.Time(w)
-	if date.Year() > 1 && date.Month() > 0 && date.Day() > 0 {
-		o.ReamazeStartDate = "start_date=" + fmt.Sprintf("%04d", date.Year()) + "-" + fmt.Sprintf("%02d", date.Month()) + "-" + fmt.Sprintf("%02d", date.Day())
-	}
+	o.setDate("start_date", time.Time(w))
 }
 
 func (w ReamazePage) Apply(o *ReamazeOptions) {
-	if w > 0 {
-		o.ReamazePage = "page=" + strconv.Itoa(int(w))
-	}
+	o.setPage("WithPage", int(w))
 }
 
 func (w ReamazeTags) Apply(o *ReamazeOptions) {
 	if len(w) > 0 {
-		o.ReamazeTag = "tag=" + url.QueryEscape(strings.Join(w, ","))
+		o.set("tag", strings.Join(w, ","))
 	}
 }
 
@@ -161,70 +158,15 @@ func newSettings(opts []ConversationsOption) (*ReamazeOptions, error) {
 	for _, opt := range opts {
 		opt.Apply(&o)
 	}
+	if err := o.Err(); err != nil {
+		return nil, err
+	}
 	return &o, nil
 }
 
+// ReamazeOptions are the query parameters of GetConversations set by ConversationsOption
 type ReamazeOptions struct {
-	ReamazeFilter    string
-	ReamazeFor       string
-	ReamazeForID     string
-	ReamazeSort      string
-	ReamazeTag       string
-	ReamazeCategory  string
-	ReamazeData      string
-	ReamazePage      string
-	ReamazeStartDate string
-	ReamazeEndDate   string
-}
-
-func (r ReamazeOptions) GetQuery() string {
-	output := ""
-	var queryParams []string
-	// checking if we have filter
-	if len(r.ReamazeFilter) > 0 {
-		queryParams = append(queryParams, r.ReamazeFilter)
-	}
-	// checking if we want message with specific email address
-	if len(r.ReamazeFor) > 0 {
-		queryParams = append(queryParams, r.ReamazeFor)
-	}
-	// checking if for_id has been set
-	if len(r.ReamazeForID) > 0 {
-		queryParams = append(queryParams, r.ReamazeForID)
-	}
-	// checking if sort param has been set
-	if len(r.ReamazeSort) > 0 {
-		queryParams = append(queryParams, r.ReamazeSort)
-	}
-	// checking if start_date is set
-	if len(r.ReamazeStartDate) > 0 {
-		queryParams = append(queryParams, r.ReamazeStartDate)
-	}
-	// checking if end_date is set
-	if len(r.ReamazeStartDate) > 0 {
-		queryParams = append(queryParams, r.ReamazeEndDate)
-	}
-	// checking if page is set
-	if len(r.ReamazePage) > 0 {
-		queryParams = append(queryParams, r.ReamazePage)
-	}
-	// checking if category is set
-	if len(r.ReamazeCategory) > 0 {
-		queryParams = append(queryParams, r.ReamazeCategory)
-	}
-	// checking if we have any tags set
-	if len(r.ReamazeTag) > 0 {
-		queryParams = append(queryParams, r.ReamazeTag)
-	}
-	// checking if we have data set
-	if len(r.ReamazeData) > 0 {
-		queryParams = append(queryParams, r.ReamazeData)
-	}
-	output = strings.Join(queryParams, "&")
-	if len(output) > 0 {
-		output = "?" + output
-	}
-	return output
+	ReamazeQuery
 }
 
 const (
@@ -239,152 +181,106 @@ const (
 	ReamazeStatusChatbotResolved
 )
 
+// origin of the conversation or message, the codes partly overlap with ReamazeChannelType but aren't the same e.g. chat is 0
+const (
+	ReamazeOriginChat          ReamazeOrigin = 0
+	ReamazeOriginEmail         ReamazeOrigin = 1
+	ReamazeOriginTwitter       ReamazeOrigin = 2
+	ReamazeOriginFacebook      ReamazeOrigin = 3
+	ReamazeOriginClassicChat   ReamazeOrigin = 6
+	ReamazeOriginAPI           ReamazeOrigin = 7
+	ReamazeOriginInstagram     ReamazeOrigin = 8
+	ReamazeOriginSMS           ReamazeOrigin = 9
+	ReamazeOriginWhatsApp      ReamazeOrigin = 15
+	ReamazeOriginStaffOutbound ReamazeOrigin = 16
+	ReamazeOriginContactForm   ReamazeOrigin = 17
+)
+
 type CreateConversationResponse struct {
-	Subject   string    `json:"subject"`
-	Slug      string    `json:"slug"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	Origin    int       `json:"origin"`
-	Data      any       `json:"data"`
-	HoldUntil any       `json:"hold_until"`
-	Author    struct {
-		ID           int    `json:"id"`
-		Name         string `json:"name"`
-		Data         any    `json:"data"`
-		Email        string `json:"email"`
-		Twitter      string `json:"twitter"`
-		Facebook     string `json:"facebook"`
-		Instagram    string `json:"instagram"`
-		Mobile       string `json:"mobile"`
-		FriendlyName string `json:"friendly_name"`
-		DisplayName  string `json:"display_name"`
-	} `json:"author"`
-	Assignee       any      `json:"assignee"`
-	PermaURL       string   `json:"perma_url"`
-	TagList        []string `json:"tag_list"`
-	Status         int      `json:"status"`
-	DisplaySubject string   `json:"display_subject"`
-	Category       struct {
-		Name                     string `json:"name"`
-		Slug                     string `json:"slug"`
-		Email                    string `json:"email"`
-		Channel                  int    `json:"channel"`
-		SettingsDisplayHTMLEmail string `json:"settings_display_html_email"`
-	} `json:"category"`
-	LastCustomerMessage struct {
-		Body      string    `json:"body"`
-		CreatedAt time.Time `json:"created_at"`
-	} `json:"last_customer_message"`
-	Followers []struct {
-		ID           int    `json:"id"`
-		Name         string `json:"name"`
-		Data         any    `json:"data"`
-		Email        string `json:"email"`
-		Twitter      string `json:"twitter"`
-		Facebook     string `json:"facebook"`
-		Instagram    string `json:"instagram"`
-		Mobile       string `json:"mobile"`
-		FriendlyName string `json:"friendly_name"`
-		DisplayName  string `json:"display_name"`
-		Staff        bool   `json:"staff?"`
-		Customer     bool   `json:"customer?"`
-		Bot          bool   `json:"bot?"`
-	} `json:"followers"`
-	Message struct {
-		Body string `json:"body"`
-	} `json:"message"`
-	ReadOnly     bool `json:"readOnly"`
-	MessageCount int  `json:"message_count"`
+	Subject             string         `json:"subject"`
+	Slug                string         `json:"slug"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	Origin              ReamazeOrigin  `json:"origin"`
+	Data                any            `json:"data"`
+	HoldUntil           any            `json:"hold_until"`
+	Author              User           `json:"author"`
+	Assignee            any            `json:"assignee"`
+	PermaURL            string         `json:"perma_url"`
+	TagList             []string       `json:"tag_list"`
+	Status              ReamazeStatus  `json:"status"`
+	DisplaySubject      string         `json:"display_subject"`
+	Category            Category       `json:"category"`
+	LastCustomerMessage MessageSummary `json:"last_customer_message"`
+	Followers           []Follower     `json:"followers"`
+	Message             MessageSummary `json:"message"`
+	ReadOnly            bool           `json:"readOnly"`
+	MessageCount        int            `json:"message_count"`
+}
+
+// ConversationMessage is the first message of the conversation created by CreateConversation
+type ConversationMessage struct {
+	Body        string   `json:"body,omitempty"`
+	Attachment  string   `json:"attachment,omitempty"`
+	Attachments []string `json:"attachments,omitempty"`
+}
+
+// ConversationRequest is the conversation created by CreateConversation
+type ConversationRequest struct {
+	Subject             string              `json:"subject,omitempty"`
+	Category            string              `json:"category,omitempty"`
+	TagList             []string            `json:"tag_list,omitempty"`
+	Status              *ReamazeStatus      `json:"status,omitempty"`                 // nil leaves the default, use Ptr(ReamazeStatusPending) to set it
+	SupressNotification bool                `json:"suppress_notifications,omitempty"` // You can optionally pass in a message[suppress_notifications] boolean attribute with a value of true to prevent Reamaze from sending any email (or integration) notifications related to this message.
+	SupressAutoresolve  bool                `json:"suppress_autoresolve,omitempty"`   // You can optionally pass in a message[suppress_autoresolve] boolean attribute with a value of true to prevent Reamaze from marking the conversation as resolved when message[user] is a staff user.
+	Data                any                 `json:"data,omitempty"`
+	Message             ConversationMessage `json:"message,omitempty"`
+	User                User                `json:"user,omitempty"`
 }
 
 type CreateConversationRequest struct {
-	Conversation struct {
-		Subject             string        `json:"subject,omitempty"`
-		Category            string        `json:"category,omitempty"`
-		TagList             []string      `json:"tag_list,omitempty"`
-		Status              ReamazeStatus `json:"status,omitempty"`
-		SupressNotification bool          `json:"suppress_notifications,omitempty"` // You can optionally pass in a message[suppress_notifications] boolean attribute with a value of true to prevent Reamaze from sending any email (or integration) notifications related to this message.
-		SupressAutoresolve  bool          `json:"suppress_autoresolve,omitempty"`   // You can optionally pass in a message[suppress_autoresolve] boolean attribute with a value of true to prevent Reamaze from marking the conversation as resolved when message[user] is a staff user.
-		Data                any           `json:"data,omitempty"`
-		Message             struct {
-			Body        string   `json:"body,omitempty"`
-			Attachment  string   `json:"attachment,omitempty"`
-			Attachments []string `json:"attachments,omitempty"`
-		} `json:"message,omitempty"`
-		User struct {
-			Name  string `json:"name,omitempty"`
-			Email string `json:"email,omitempty"`
-			Data  any    `json:"data,omitempty"`
-		} `json:"user,omitempty"`
-	} `json:"conversation,omitempty"`
+	Conversation ConversationRequest `json:"conversation,omitempty"`
 }
+
+// ConversationUpdateRequest is a partial update, only fields which aren't nil are sent so that the others stay unchanged.
+// Zero values are sent when set explicitly e.g. Ptr(ReamazeStatusUnresolved) reopens the conversation
+// and Ptr([]string{}) removes all tags.
+// Followers aren't listed as the API doesn't update them.
+type ConversationUpdateRequest struct {
+	TagList   *[]string      `json:"tag_list,omitempty"`
+	Status    *ReamazeStatus `json:"status,omitempty"`
+	HoldUntil *time.Time     `json:"hold_until,omitempty"` // used together with ReamazeStatusOnHold
+	Data      any            `json:"data,omitempty"`
+	Assignee  *string        `json:"assignee,omitempty"`
+	Category  *string        `json:"category,omitempty"`
+	Brand     *string        `json:"brand,omitempty"`
+}
+
+// UpdateConversationRequest is the request of UpdateConversation, see ConversationUpdateRequest
 type UpdateConversationRequest struct {
-	Conversation struct {
-		TagList  []string      `json:"tag_list,omitempty"`
-		Status   ReamazeStatus `json:"status,omitempty"`
-		Data     any           `json:"data,omitempty"`
-		Assignee string        `json:"assignee,omitempty"`
-		Category string        `json:"category,omitempty"`
-		Brand    string        `json:"brand,omitempty"`
-	} `json:"conversation,omitempty"`
+	Conversation ConversationUpdateRequest `json:"conversation,omitempty"`
 }
 
 type GetConversationResponse struct {
-	Subject   string    `json:"subject,omitempty"`
-	Slug      string    `json:"slug,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	Origin    int       `json:"origin,omitempty"`
-	Data      any       `json:"data,omitempty"`
-	HoldUntil any       `json:"hold_until,omitempty"`
-	Author    struct {
-		ID           int    `json:"id,omitempty"`
-		Name         string `json:"name,omitempty"`
-		Data         any    `json:"data"`
-		Email        string `json:"email,omitempty"`
-		Twitter      string `json:"twitter,omitempty"`
-		Facebook     string `json:"facebook,omitempty"`
-		Instagram    string `json:"instagram,omitempty"`
-		Mobile       string `json:"mobile,omitempty"`
-		FriendlyName string `json:"friendly_name,omitempty"`
-		DisplayName  string `json:"display_name,omitempty"`
-	} `json:"author"`
-	Assignee       any      `json:"assignee,omitempty"`
-	PermaURL       string   `json:"perma_url,omitempty"`
-	TagList        []string `json:"tag_list,omitempty"`
-	Status         int      `json:"status,omitempty"`
-	DisplaySubject string   `json:"display_subject,omitempty"`
-	Category       struct {
-		Name                     string `json:"name,omitempty"`
-		Slug                     string `json:"slug,omitempty"`
-		Email                    string `json:"email,omitempty"`
-		Channel                  int    `json:"channel,omitempty"`
-		SettingsDisplayHTMLEmail string `json:"settings_display_html_email,omitempty"`
-	} `json:"category,omitempty"`
-	LastCustomerMessage struct {
-		Body      string    `json:"body,omitempty"`
-		CreatedAt time.Time `json:"created_at,omitempty"`
-	} `json:"last_customer_message,omitempty"`
-	Followers []struct {
-		ID           int    `json:"id,omitempty"`
-		Name         string `json:"name,omitempty"`
-		Data         any    `json:"data"`
-		Email        string `json:"email,omitempty"`
-		Twitter      string `json:"twitter,omitempty"`
-		Facebook     string `json:"facebook,omitempty"`
-		Instagram    string `json:"instagram,omitempty"`
-		Mobile       string `json:"mobile,omitempty"`
-		FriendlyName string `json:"friendly_name,omitempty"`
-		DisplayName  string `json:"display_name,omitempty"`
-		Staff        bool   `json:"staff?,omitempty"`
-		Customer     bool   `json:"customer?,omitempty"`
-		Bot          bool   `json:"bot?,omitempty"`
-	} `json:"followers,omitempty"`
-	Message struct {
-		Body string `json:"body,omitempty"`
-	} `json:"message"`
-	ReadOnly     bool `json:"readOnly,omitempty"`
-	MessageCount int  `json:"message_count,omitempty"`
+	Subject             string         `json:"subject,omitempty"`
+	Slug                string         `json:"slug,omitempty"`
+	CreatedAt           time.Time      `json:"created_at,omitempty"`
+	UpdatedAt           time.Time      `json:"updated_at,omitempty"`
+	Origin              ReamazeOrigin  `json:"origin,omitempty"`
+	Data                any            `json:"data,omitempty"`
+	HoldUntil           any            `json:"hold_until,omitempty"`
+	Author              User           `json:"author"`
+	Assignee            any            `json:"assignee,omitempty"`
+	PermaURL            string         `json:"perma_url,omitempty"`
+	TagList             []string       `json:"tag_list,omitempty"`
+	Status              ReamazeStatus  `json:"status,omitempty"`
+	DisplaySubject      string         `json:"display_subject,omitempty"`
+	Category            Category       `json:"category,omitempty"`
+	LastCustomerMessage MessageSummary `json:"last_customer_message,omitempty"`
+	Followers           []Follower     `json:"followers,omitempty"`
+	Message             MessageSummary `json:"message"`
+	ReadOnly            bool           `json:"readOnly,omitempty"`
+	MessageCount        int            `json:"message_count,omitempty"`
 }
 type GetConversationsResponse struct {
 	PageSize      int                       `json:"page_size,omitempty"`
@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
@@ -306,7 +307,7 @@ func TestClient_GetConversations(t *testing.T) {
 
 func TestClient_UpdateConversation(t *testing.T) {
 	updateConversationReq := &UpdateConversationRequest{}
-	updateConversationReq.Conversation.Status = ReamazeStatusArchived
+	updateConversationReq.Conversation.Status = Ptr(ReamazeStatusArchived)
 	type fields struct {
 		baseURL    string
 		auth       string
@@ -418,6 +419,57 @@ func TestClient_UpdateConversation(t *testing.T) {
 	}
 }
 
+func TestUpdateConversationRequest_Marshal(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(req *UpdateConversationRequest)
+		want   string
+	}{
+		{
+			name:   "Testing unset fields",
+			update: func(req *UpdateConversationRequest) {},
+			want:   `{"conversation":{}}`,
+		},
+		{
+			name:   "Testing zero status",
+			update: func(req *UpdateConversationRequest) { req.Conversation.Status = Ptr(ReamazeStatusUnresolved) },
+			want:   `{"conversation":{"status":0}}`,
+		},
+		{
+			name: "Testing empty tags and assignee",
+			update: func(req *UpdateConversationRequest) {
+				req.Conversation.TagList = Ptr([]string{})
+				req.Conversation.Assignee = Ptr("")
+			},
+			want: `{"conversation":{"tag_list":[],"assignee":""}}`,
+		},
+		{
+			name: "Testing all fields",
+			update: func(req *UpdateConversationRequest) {
+				req.Conversation.TagList = Ptr([]string{"vip"})
+				req.Conversation.Status = Ptr(ReamazeStatusOnHold)
+				req.Conversation.Assignee = Ptr("agent@example.com")
+				req.Conversation.Category = Ptr("support")
+				req.Conversation.Brand = Ptr("brand")
+			},
+			want: `{"conversation":{"tag_list":["vip"],"status":5,"assignee":"agent@example.com","category":"support","brand":"brand"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := UpdateConversationRequest{}
+			tt.update(&req)
+			got, err := json.Marshal(req)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestReamazeFilter_Apply(t *testing.T) {
 	type args struct {
 		o *ReamazeOptions
@@ -426,26 +478,26 @@ func TestReamazeFilter_Apply(t *testing.T) {
 		name string
 		w    ReamazeFilter
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if reamaze filter all is being set",
 			w:    ReamazeFilterAll,
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeFilter: string("filter=" + ReamazeFilterAll)},
+			want: "?filter=" + string(ReamazeFilterAll),
 		},
 		{
 			name: "Testing if reamaze filter is not being set when it's empty",
 			w:    ReamazeFilter(""),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeFilter.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -460,26 +512,26 @@ func TestReamazeFor_Apply(t *testing.T) {
 		name string
 		w    ReamazeFor
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if reamaze for filter is being added to ReamazeOptions with dummy email",
 			w:    ReamazeFor("dummy@example.com"),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeFor: "for=" + url.QueryEscape(string(ReamazeFor("dummy@example.com")))},
+			want: "?for=" + url.QueryEscape(string(ReamazeFor("dummy@example.com"))),
 		},
 		{
 			name: "Testing if reamaze for filter is not being added to ReamazeOptions with invalid email",
 			w:    ReamazeFor("dummy"),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeFor.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -494,26 +546,26 @@ func TestReamazeForID_Apply(t *testing.T) {
 		name string
 		w    ReamazeForID
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeForID is being set in ReamazeOptions with id",
 			w:    ReamazeForID("dummy"),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeForID: "for_id=" + url.QueryEscape("dummy")},
+			want: "?for_id=" + url.QueryEscape("dummy"),
 		},
 		{
 			name: "Testing if ReamazeForID is not being set in ReamazeOptions with id when empty",
 			w:    ReamazeForID(""),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeForID.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -528,26 +580,26 @@ func TestReamazeSort_Apply(t *testing.T) {
 		name string
 		w    ReamazeSort
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeSort is being set in ReamazeOptions with ReamazeSort value",
 			w:    ReamazeSortChanged,
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeSort: "sort=" + string(ReamazeSortChanged)},
+			want: "?sort=" + string(ReamazeSortChanged),
 		},
 		{
 			name: "Testing if ReamazeSort is not being set in ReamazeOptions with when empty",
 			w:    ReamazeSortChanged,
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeSort: "sort=" + string(ReamazeSortChanged)},
+			want: "?sort=" + string(ReamazeSortChanged),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeSort.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -562,26 +614,26 @@ func TestReamazeData_Apply(t *testing.T) {
 		name string
 		w    ReamazeData
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeSort is being set in ReamazeOptions with ReamazeSort value",
 			w:    ReamazeData{"dummy": "dummy"},
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeData: "data[dummy]=dummy"},
+			want: "?data%5Bdummy%5D=dummy",
 		},
 		{
 			name: "Testing if ReamazeSort is not being set in ReamazeOptions with when empty",
 			w:    ReamazeData{},
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeData.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -596,26 +648,26 @@ func TestReamazeCategory_Apply(t *testing.T) {
 		name string
 		w    ReamazeCategory
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeCategory is being set in ReamazeOptions with ReamazeCategory value",
 			w:    ReamazeCategory("dummy"),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeCategory: "category=dummy"},
+			want: "?category=dummy",
 		},
 		{
 			name: "Testing if ReamazeCategory is not being set in ReamazeOptions when empty",
 			w:    ReamazeCategory("dummy"),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeCategory: "category=dummy"},
+			want: "?category=dummy",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeCategory.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -630,26 +682,26 @@ func TestReamazeEndDate_Apply(t *testing.T) {
 		name string
 		w    ReamazeEndDate
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeEndDate is  being set in ReamazeOptions",
 			w:    ReamazeEndDate(time.Date(2024, time.Month(1), 1, 0, 0, 0, 0, time.UTC)),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeEndDate: "end_date=2024-01-01"},
+			want: "?end_date=2024-01-01",
 		},
 		{
 			name: "Testing if ReamazeEndDate is not being set in ReamazeOptions if date is empty",
 			w:    ReamazeEndDate{},
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeEndDate.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -664,26 +716,26 @@ func TestReamazeStartDate_Apply(t *testing.T) {
 		name string
 		w    ReamazeStartDate
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeStartDate is  being set in ReamazeOptions",
 			w:    ReamazeStartDate(time.Date(2024, time.Month(1), 1, 0, 0, 0, 0, time.UTC)),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeStartDate: "start_date=2024-01-01"},
+			want: "?start_date=2024-01-01",
 		},
 		{
 			name: "Testing if ReamazeStartDate is not being set in ReamazeOptions if date is empty",
 			w:    ReamazeStartDate{},
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeStartDate.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -698,26 +750,26 @@ func TestReamazeTags_Apply(t *testing.T) {
 		name string
 		w    ReamazeTags
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeTags is not being set in ReamazeOptions if we have empty tags",
 			w:    ReamazeTags{},
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 		{
 			name: "Testing if ReamazeTags is being set in ReamazeOptions",
 			w:    ReamazeTags{"dummy", "dummy2"},
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazeTag: "tag=" + url.QueryEscape("dummy,dummy2")},
+			want: "?tag=" + url.QueryEscape("dummy,dummy2"),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeTags.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -732,26 +784,26 @@ func TestReamazePage_Apply(t *testing.T) {
 		name string
 		w    ReamazePage
 		args args
-		want *ReamazeOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazePage is not being set in ReamazeOptions if page 0",
 			w:    ReamazePage(0),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{},
+			want: "",
 		},
 		{
 			name: "Testing if ReamazePage is being set in ReamazeOptions",
 			w:    ReamazePage(2),
 			args: args{o: &ReamazeOptions{}},
-			want: &ReamazeOptions{ReamazePage: "page=2"},
+			want: "?page=2",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazePage.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -1024,21 +1076,41 @@ func Test_newSettings(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    *ReamazeOptions
+		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Testing newSettings expansion when no arguments provided",
 			args:    args{},
-			want:    &ReamazeOptions{},
+			want:    "",
 			wantErr: false,
 		},
 		{
 			name:    "Testing newSettings WithFilter Option ReamazeFilterAll",
 			args:    args{opts: []ConversationsOption{WithFilter(ReamazeFilterAll)}},
-			want:    &ReamazeOptions{ReamazeFilter: string("filter=" + ReamazeFilterAll)},
+			want:    "?filter=" + string(ReamazeFilterAll),
 			wantErr: false,
 		},
+		{
+			name:    "Testing newSettings WithFor with invalid email",
+			args:    args{opts: []ConversationsOption{WithFor("dummy")}},
+			wantErr: true,
+		},
+		{
+			name:    "Testing newSettings WithPage with negative page",
+			args:    args{opts: []ConversationsOption{WithPage(-1)}},
+			wantErr: true,
+		},
+		{
+			name:    "Testing newSettings WithData with empty key",
+			args:    args{opts: []ConversationsOption{WithData(map[string]string{"": "dummy"})}},
+			wantErr: true,
+		},
+		{
+			name:    "Testing newSettings with start date after end date",
+			args:    args{opts: []ConversationsOption{WithStartDate(2024, 2, 1), WithEndDate(2024, 1, 1)}},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1047,66 +1119,48 @@ func Test_newSettings(t *testing.T) {
 				t.Errorf("newSettings() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("newSettings() = %v, want %v", got, tt.want)
+			if err == nil && got.GetQuery() != tt.want {
+				t.Errorf("newSettings() = %v, want %v", got.GetQuery(), tt.want)
 			}
 		})
 	}
 }
 
 func TestReamazeOptions_GetQuery(t *testing.T) {
-	type fields struct {
-		ReamazeFilter    string
-		ReamazeFor       string
-		ReamazeForID     string
-		ReamazeSort      string
-		ReamazeTag       string
-		ReamazeCategory  string
-		ReamazeData      string
-		ReamazePage      string
-		ReamazeStartDate string
-		ReamazeEndDate   string
-	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   string
+		name string
+		opts []ConversationsOption
+		want string
 	}{
 		{
-			name:   "Testing ReamazeOptions.GetQuery no fields set",
-			fields: fields{},
-			want:   "",
+			name: "Testing ReamazeOptions.GetQuery no options set",
+			want: "",
 		},
 		{
-			name: "Testing ReamazeOptions.GetQuery with all fields set to dummy",
-			fields: fields{
-				ReamazeFilter:    "dummy",
-				ReamazeFor:       "dummy",
-				ReamazeForID:     "dummy",
-				ReamazeSort:      "dummy",
-				ReamazeTag:       "dummy",
-				ReamazeCategory:  "dummy",
-				ReamazeData:      "dummy",
-				ReamazePage:      "dummy",
-				ReamazeStartDate: "dummy",
-				ReamazeEndDate:   "dummy",
+			name: "Testing ReamazeOptions.GetQuery with all options set",
+			opts: []ConversationsOption{
+				WithFilter(ReamazeFilterAll), WithFor("dummy@example.com"), WithForID("dummy"), WithSort(ReamazeSortChanged),
+				WithTags("a", "b"), WithCategory("dummy"), WithData(map[string]string{"b": "2", "a": "1"}), WithPage(2),
+				WithStartDate(2024, 1, 1), WithEndDate(2024, 1, 31),
 			},
-			want: "?dummy&dummy&dummy&dummy&dummy&dummy&dummy&dummy&dummy&dummy",
+			want: "?category=dummy&data%5Ba%5D=1&data%5Bb%5D=2&end_date=2024-01-31&filter=all&for=dummy%40example.com&for_id=dummy&page=2&sort=changed&start_date=2024-01-01&tag=a%2Cb",
+		},
+		{
+			name: "Testing ReamazeOptions.GetQuery with end date only",
+			opts: []ConversationsOption{WithEndDate(2024, 1, 31)},
+			want: "?end_date=2024-01-31",
+		},
+		{
+			name: "Testing ReamazeOptions.GetQuery with option set twice",
+			opts: []ConversationsOption{WithPage(1), WithPage(3)},
+			want: "?page=3",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := ReamazeOptions{
-				ReamazeFilter:    tt.fields.ReamazeFilter,
-				ReamazeFor:       tt.fields.ReamazeFor,
-				ReamazeForID:     tt.fields.ReamazeForID,
-				ReamazeSort:      tt.fields.ReamazeSort,
-				ReamazeTag:       tt.fields.ReamazeTag,
-				ReamazeCategory:  tt.fields.ReamazeCategory,
-				ReamazeData:      tt.fields.ReamazeData,
-				ReamazePage:      tt.fields.ReamazePage,
-				ReamazeStartDate: tt.fields.ReamazeStartDate,
-				ReamazeEndDate:   tt.fields.ReamazeEndDate,
+			var r ReamazeOptions
+			for _, opt := range tt.opts {
+				opt.Apply(&r)
 			}
 			if got := r.GetQuery(); got != tt.want {
 				t.Errorf("ReamazeOptions.GetQuery() = %v, want %v", got, tt.want)
@@ -0,0 +1,164 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AssignConversation assigns the conversation to the staff user with given email and returns the updated conversation
+func (c *Client) AssignConversation(slug string, staffEmail string) (*GetConversationResponse, error) {
+	return c.AssignConversationWithContext(context.Background(), slug, staffEmail)
+}
+
+// AssignConversationWithContext is like AssignConversation but uses ctx for the underlying request.
+func (c *Client) AssignConversationWithContext(ctx context.Context, slug string, staffEmail string) (*GetConversationResponse, error) {
+	// checking if we have staff email set
+	if len(staffEmail) == 0 {
+		return nil, errors.New("AssignConversation staff email cannot be empty, please provide staff email as argument")
+	}
+	req := &UpdateConversationRequest{}
+	req.Conversation.Assignee = &staffEmail
+	return c.UpdateConversationWithContext(ctx, slug, req)
+}
+
+// AddConversationTags adds tags to the ones the conversation already has, tags it has already are skipped (case-insensitive).
+// The conversation is read and then updated with the merged tags, so tags changed by someone else in between may be overwritten.
+func (c *Client) AddConversationTags(slug string, tags ...string) (*GetConversationResponse, error) {
+	return c.AddConversationTagsWithContext(context.Background(), slug, tags...)
+}
+
+// AddConversationTagsWithContext is like AddConversationTags but uses ctx for the underlying requests.
+func (c *Client) AddConversationTagsWithContext(ctx context.Context, slug string, tags ...string) (*GetConversationResponse, error) {
+	// checking if we have any tags to add
+	if len(tags) == 0 {
+		return nil, errors.New("AddConversationTags tags cannot be empty, please provide at least one tag as argument")
+	}
+	return c.updateConversationTags(ctx, slug, func(current []string) []string {
+		for _, tag := range tags {
+			if !containsTag(current, tag) {
+				current = append(current, tag)
+			}
+		}
+		return current
+	})
+}
+
+// RemoveConversationTags removes tags from the conversation (case-insensitive), tags it doesn't have are ignored.
+// The conversation is read and then updated with the remaining tags, so tags changed by someone else in between may be overwritten.
+func (c *Client) RemoveConversationTags(slug string, tags ...string) (*GetConversationResponse, error) {
+	return c.RemoveConversationTagsWithContext(context.Background(), slug, tags...)
+}
+
+// RemoveConversationTagsWithContext is like RemoveConversationTags but uses ctx for the underlying requests.
+func (c *Client) RemoveConversationTagsWithContext(ctx context.Context, slug string, tags ...string) (*GetConversationResponse, error) {
+	// checking if we have any tags to remove
+	if len(tags) == 0 {
+		return nil, errors.New("RemoveConversationTags tags cannot be empty, please provide at least one tag as argument")
+	}
+	return c.updateConversationTags(ctx, slug, func(current []string) []string {
+		remaining := []string{}
+		for _, tag := range current {
+			if !containsTag(tags, tag) {
+				remaining = append(remaining, tag)
+			}
+		}
+		return remaining
+	})
+}
+
+// ResolveConversation sets the conversation status to ReamazeStatusResolved
+func (c *Client) ResolveConversation(slug string) (*GetConversationResponse, error) {
+	return c.ResolveConversationWithContext(context.Background(), slug)
+}
+
+// ResolveConversationWithContext is like ResolveConversation but uses ctx for the underlying request.
+func (c *Client) ResolveConversationWithContext(ctx context.Context, slug string) (*GetConversationResponse, error) {
+	return c.setConversationStatus(ctx, slug, ReamazeStatusResolved)
+}
+
+// ReopenConversation sets the conversation status back to ReamazeStatusUnresolved
+func (c *Client) ReopenConversation(slug string) (*GetConversationResponse, error) {
+	return c.ReopenConversationWithContext(context.Background(), slug)
+}
+
+// ReopenConversationWithContext is like ReopenConversation but uses ctx for the underlying request.
+func (c *Client) ReopenConversationWithContext(ctx context.Context, slug string) (*GetConversationResponse, error) {
+	return c.setConversationStatus(ctx, slug, ReamazeStatusUnresolved)
+}
+
+// MarkConversationSpam sets the conversation status to ReamazeStatusSpam
+func (c *Client) MarkConversationSpam(slug string) (*GetConversationResponse, error) {
+	return c.MarkConversationSpamWithContext(context.Background(), slug)
+}
+
+// MarkConversationSpamWithContext is like MarkConversationSpam but uses ctx for the underlying request.
+func (c *Client) MarkConversationSpamWithContext(ctx context.Context, slug string) (*GetConversationResponse, error) {
+	return c.setConversationStatus(ctx, slug, ReamazeStatusSpam)
+}
+
+// HoldConversationUntil puts the conversation on hold (snoozes it) until given time
+func (c *Client) HoldConversationUntil(slug string, until time.Time) (*GetConversationResponse, error) {
+	return c.HoldConversationUntilWithContext(context.Background(), slug, until)
+}
+
+// HoldConversationUntilWithContext is like HoldConversationUntil but uses ctx for the underlying request.
+func (c *Client) HoldConversationUntilWithContext(ctx context.Context, slug string, until time.Time) (*GetConversationResponse, error) {
+	// checking if we have time set
+	if until.IsZero() {
+		return nil, errors.New("HoldConversationUntil time cannot be empty, please provide time the conversation is held until as argument")
+	}
+	req := &UpdateConversationRequest{}
+	req.Conversation.Status = Ptr(ReamazeStatusOnHold)
+	req.Conversation.HoldUntil = &until
+	return c.UpdateConversationWithContext(ctx, slug, req)
+}
+
+// MoveConversationToCategory moves the conversation to the channel with given slug
+func (c *Client) MoveConversationToCategory(slug string, category string) (*GetConversationResponse, error) {
+	return c.MoveConversationToCategoryWithContext(context.Background(), slug, category)
+}
+
+// MoveConversationToCategoryWithContext is like MoveConversationToCategory but uses ctx for the underlying request.
+func (c *Client) MoveConversationToCategoryWithContext(ctx context.Context, slug string, category string) (*GetConversationResponse, error) {
+	// checking if we have category set
+	if len(category) == 0 {
+		return nil, errors.New("MoveConversationToCategory category cannot be empty, please provide category slug as argument")
+	}
+	req := &UpdateConversationRequest{}
+	req.Conversation.Category = &category
+	return c.UpdateConversationWithContext(ctx, slug, req)
+}
+
+func (c *Client) setConversationStatus(ctx context.Context, slug string, status ReamazeStatus) (*GetConversationResponse, error) {
+	req := &UpdateConversationRequest{}
+	req.Conversation.Status = &status
+	return c.UpdateConversationWithContext(ctx, slug, req)
+}
+
+// updateConversationTags reads the conversation, applies change to its tags and saves them,
+// the conversation isn't updated when the tags stay the same
+func (c *Client) updateConversationTags(ctx context.Context, slug string, change func(current []string) []string) (*GetConversationResponse, error) {
+	conversation, err := c.GetConversationWithContext(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	tags := change(slices.Clone(conversation.TagList))
+	if slices.Equal(conversation.TagList, tags) {
+		return conversation, nil
+	}
+	req := &UpdateConversationRequest{}
+	req.Conversation.TagList = &tags
+	return c.UpdateConversationWithContext(ctx, slug, req)
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package reamaze
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// workflowClient returns client answering GET with conversation tagged with tags and PUT with the same conversation,
+// requests are recorded as "METHOD body" in requests
+func workflowClient(tags string, requests *[]string) *Client {
+	return &Client{
+		baseURL: "https://dummy.reamaze.io",
+		auth:    "dummy",
+		httpClient: &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				entry := req.Method
+				if req.Body != nil {
+					body, _ := io.ReadAll(req.Body)
+					if len(body) > 0 {
+						entry += " " + string(body)
+					}
+				}
+				*requests = append(*requests, entry)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Status:     "200 OK",
+					Body:       io.NopCloser(strings.NewReader(`{"slug":"dummy","tag_list":` + tags + `}`)),
+				}
+			}),
+		},
+	}
+}
+
+func TestClient_ConversationWorkflow(t *testing.T) {
+	until := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		tags    string
+		call    func(c *Client) (*GetConversationResponse, error)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Testing assigning conversation",
+			call: func(c *Client) (*GetConversationResponse, error) {
+				return c.AssignConversation("dummy", "agent@example.com")
+			},
+			want: []string{`PUT {"conversation":{"assignee":"agent@example.com"}}`},
+		},
+		{
+			name:    "Testing assigning conversation without staff email",
+			call:    func(c *Client) (*GetConversationResponse, error) { return c.AssignConversation("dummy", "") },
+			wantErr: true,
+		},
+		{
+			name: "Testing adding tags",
+			tags: `["orders"]`,
+			call: func(c *Client) (*GetConversationResponse, error) {
+				return c.AddConversationTags("dummy", "vip", "Orders", "vip")
+			},
+			want: []string{"GET", `PUT {"conversation":{"tag_list":["orders","vip"]}}`},
+		},
+		{
+			name: "Testing adding tags the conversation already has",
+			tags: `["orders","vip"]`,
+			call: func(c *Client) (*GetConversationResponse, error) { return c.AddConversationTags("dummy", "VIP") },
+			want: []string{"GET"},
+		},
+		{
+			name:    "Testing adding no tags",
+			call:    func(c *Client) (*GetConversationResponse, error) { return c.AddConversationTags("dummy") },
+			wantErr: true,
+		},
+		{
+			name: "Testing removing tags",
+			tags: `["orders","vip"]`,
+			call: func(c *Client) (*GetConversationResponse, error) {
+				return c.RemoveConversationTags("dummy", "Orders", "unknown")
+			},
+			want: []string{"GET", `PUT {"conversation":{"tag_list":["vip"]}}`},
+		},
+		{
+			name: "Testing removing the last tag",
+			tags: `["vip"]`,
+			call: func(c *Client) (*GetConversationResponse, error) { return c.RemoveConversationTags("dummy", "vip") },
+			want: []string{"GET", `PUT {"conversation":{"tag_list":[]}}`},
+		},
+		{
+			name: "Testing resolving conversation",
+			call: func(c *Client) (*GetConversationResponse, error) { return c.ResolveConversation("dummy") },
+			want: []string{`PUT {"conversation":{"status":2}}`},
+		},
+		{
+			name: "Testing reopening conversation",
+			call: func(c *Client) (*GetConversationResponse, error) { return c.ReopenConversation("dummy") },
+			want: []string{`PUT {"conversation":{"status":0}}`},
+		},
+		{
+			name: "Testing marking conversation as spam",
+			call: func(c *Client) (*GetConversationResponse, error) { return c.MarkConversationSpam("dummy") },
+			want: []string{`PUT {"conversation":{"status":3}}`},
+		},
+		{
+			name: "Testing holding conversation",
+			call: func(c *Client) (*GetConversationResponse, error) { return c.HoldConversationUntil("dummy", until) },
+			want: []string{`PUT {"conversation":{"status":5,"hold_until":"2024-01-02T09:00:00Z"}}`},
+		},
+		{
+			name: "Testing holding conversation without time",
+			call: func(c *Client) (*GetConversationResponse, error) {
+				return c.HoldConversationUntil("dummy", time.Time{})
+			},
+			wantErr: true,
+		},
+		{
+			name: "Testing moving conversation",
+			call: func(c *Client) (*GetConversationResponse, error) {
+				return c.MoveConversationToCategory("dummy", "billing")
+			},
+			want: []string{`PUT {"conversation":{"category":"billing"}}`},
+		},
+		{
+			name:    "Testing moving conversation without category",
+			call:    func(c *Client) (*GetConversationResponse, error) { return c.MoveConversationToCategory("dummy", "") },
+			wantErr: true,
+		},
+		{
+			name:    "Testing empty slug",
+			call:    func(c *Client) (*GetConversationResponse, error) { return c.ResolveConversation("") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.tags) == 0 {
+				tt.tags = "[]"
+			}
+			var requests []string
+			got, err := tt.call(workflowClient(tt.tags, &requests))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(requests) > 0 {
+					t.Errorf("requests = %v, want none", requests)
+				}
+				return
+			}
+			if got == nil || got.Slug != "dummy" {
+				t.Errorf("conversation = %+v", got)
+			}
+			if strings.Join(requests, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("requests = %v, want %v", requests, tt.want)
+			}
+		})
+	}
+}
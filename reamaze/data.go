@@ -0,0 +1,121 @@
+package reamaze
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// DataValidator can be implemented by custom data structs to be checked by DecodeData and EncodeData
+type DataValidator interface {
+	Validate() error
+}
+
+// DecodeData converts custom data returned by re:amaze (e.g. GetContactResponse.Data or GetConversationResponse.Data) into T
+//
+//	type Customer struct {
+//		Plan string `json:"plan"`
+//	}
+//	customer, err := reamaze.DecodeData[Customer](contact.Data)
+func DecodeData[T any](data any) (T, error) {
+	var output T
+	if data == nil {
+		return output, nil
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return output, fmt.Errorf("reamaze: cannot decode data into %T: %w", output, err)
+	}
+	// re:amaze returns empty data as an empty string
+	if bytes.Equal(encoded, []byte(`""`)) {
+		return output, nil
+	}
+	if err := json.Unmarshal(encoded, &output); err != nil {
+		return output, fmt.Errorf("reamaze: cannot decode data into %T: %w", output, err)
+	}
+	if err := validateData(&output); err != nil {
+		return output, err
+	}
+	return output, nil
+}
+
+// EncodeData converts T into custom data accepted by re:amaze, which has to be a JSON object
+func EncodeData[T any](data T) (json.RawMessage, error) {
+	if err := validateData(&data); err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("reamaze: cannot encode data %T: %w", data, err)
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("reamaze: data %T has to be encoded as JSON object, got %s", data, encoded)
+	}
+	return encoded, nil
+}
+
+// validateData calls Validate when data, or the value it points to, implements DataValidator
+func validateData[T any](data *T) error {
+	validator, ok := any(data).(DataValidator)
+	if !ok {
+		validator, ok = any(*data).(DataValidator)
+	}
+	if !ok {
+		return nil
+	}
+	if err := validator.Validate(); err != nil {
+		return fmt.Errorf("reamaze: invalid data %T: %w", *data, err)
+	}
+	return nil
+}
+
+// SetData sets conversation custom data from any struct or map encoded as JSON object
+func (r *CreateConversationRequest) SetData(data any) error {
+	encoded, err := EncodeData(data)
+	if err != nil {
+		return err
+	}
+	r.Conversation.Data = encoded
+	return nil
+}
+
+// SetUserData sets custom data of the conversation user from any struct or map encoded as JSON object
+func (r *CreateConversationRequest) SetUserData(data any) error {
+	encoded, err := EncodeData(data)
+	if err != nil {
+		return err
+	}
+	r.Conversation.User.Data = encoded
+	return nil
+}
+
+// SetData sets conversation custom data from any struct or map encoded as JSON object
+func (r *UpdateConversationRequest) SetData(data any) error {
+	encoded, err := EncodeData(data)
+	if err != nil {
+		return err
+	}
+	r.Conversation.Data = encoded
+	return nil
+}
+
+// SetData sets contact custom data from any struct or map encoded as JSON object
+func (r *CreateContactRequest) SetData(data any) error {
+	encoded, err := EncodeData(data)
+	if err != nil {
+		return err
+	}
+	r.Contact.Data = encoded
+	return nil
+}
+
+// SetData sets contact custom data from any struct or map encoded as JSON object
+func (r *UpdateContactRequest) SetData(data any) error {
+	encoded, err := EncodeData(data)
+	if err != nil {
+		return err
+	}
+	r.Contact.Data = encoded
+	return nil
+}
@@ -0,0 +1,168 @@
+package reamaze
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type dummyData struct {
+	FirstName string `json:"first_name,omitempty"`
+	Age       int    `json:"age,omitempty"`
+}
+
+type validatedData struct {
+	Email string `json:"email"`
+}
+
+func (d validatedData) Validate() error {
+	if len(d.Email) == 0 {
+		return errors.New("email is required")
+	}
+	return nil
+}
+
+func TestDecodeData(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    any
+		want    dummyData
+		wantErr bool
+	}{
+		{
+			name:    "Testing nil data",
+			data:    nil,
+			want:    dummyData{},
+			wantErr: false,
+		},
+		{
+			name:    "Testing empty string data",
+			data:    "",
+			want:    dummyData{},
+			wantErr: false,
+		},
+		{
+			name:    "Testing data decoded from JSON response",
+			data:    map[string]any{"first_name": "dummy", "age": float64(30), "other": "ignored"},
+			want:    dummyData{FirstName: "dummy", Age: 30},
+			wantErr: false,
+		},
+		{
+			name:    "Testing type mismatch",
+			data:    map[string]any{"age": "thirty"},
+			want:    dummyData{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeData[dummyData](tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeDataValidate(t *testing.T) {
+	if _, err := DecodeData[validatedData](map[string]any{"email": ""}); err == nil {
+		t.Errorf("DecodeData() expected validation error")
+	}
+	got, err := DecodeData[validatedData](map[string]any{"email": "test@example.com"})
+	if err != nil || got.Email != "test@example.com" {
+		t.Errorf("DecodeData() = %v, error = %v", got, err)
+	}
+}
+
+func TestEncodeData(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    any
+		want    json.RawMessage
+		wantErr bool
+	}{
+		{
+			name:    "Testing struct",
+			data:    dummyData{FirstName: "dummy"},
+			want:    json.RawMessage(`{"first_name":"dummy"}`),
+			wantErr: false,
+		},
+		{
+			name:    "Testing map",
+			data:    map[string]string{"first_name": "dummy"},
+			want:    json.RawMessage(`{"first_name":"dummy"}`),
+			wantErr: false,
+		},
+		{
+			name:    "Testing non object data",
+			data:    []string{"dummy"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "Testing nil data",
+			data:    nil,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "Testing failing validation",
+			data:    validatedData{},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeData(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EncodeData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EncodeData() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateConversationRequest_SetData(t *testing.T) {
+	req := &CreateConversationRequest{}
+	if err := req.SetData(dummyData{FirstName: "dummy"}); err != nil {
+		t.Fatalf("CreateConversationRequest.SetData() error = %v", err)
+	}
+	if err := req.SetUserData(map[string]bool{"nda": true}); err != nil {
+		t.Fatalf("CreateConversationRequest.SetUserData() error = %v", err)
+	}
+	if err := req.SetData("dummy"); err == nil {
+		t.Errorf("CreateConversationRequest.SetData() expected error for non object data")
+	}
+	got, _ := json.Marshal(req)
+	want := `{"conversation":{"data":{"first_name":"dummy"},"message":{},"user":{"data":{"nda":true}}}}`
+	if string(got) != want {
+		t.Errorf("CreateConversationRequest JSON = %s, want %s", got, want)
+	}
+}
+
+func TestRequests_SetData(t *testing.T) {
+	update := &UpdateConversationRequest{}
+	createContact := &CreateContactRequest{}
+	updateContact := &UpdateContactRequest{}
+	for _, setter := range []func(any) error{update.SetData, createContact.SetData, updateContact.SetData} {
+		if err := setter(dummyData{Age: 1}); err != nil {
+			t.Errorf("SetData() error = %v", err)
+		}
+		if err := setter(1); err == nil {
+			t.Errorf("SetData() expected error for non object data")
+		}
+	}
+	want := json.RawMessage(`{"age":1}`)
+	if !reflect.DeepEqual(update.Conversation.Data, want) || !reflect.DeepEqual(createContact.Contact.Data, want) || !reflect.DeepEqual(updateContact.Contact.Data, want) {
+		t.Errorf("SetData() = %v, %v, %v, want %s", update.Conversation.Data, createContact.Contact.Data, updateContact.Contact.Data, want)
+	}
+}
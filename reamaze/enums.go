@@ -0,0 +1,157 @@
+package reamaze
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var reamazeStatusNames = map[ReamazeStatus]string{
+	ReamazeStatusUnresolved:      "unresolved",
+	ReamazeStatusPending:         "pending",
+	ReamazeStatusResolved:        "resolved",
+	ReamazeStatusSpam:            "spam",
+	ReamazeStatusArchived:        "archived",
+	ReamazeStatusOnHold:          "on_hold",
+	ReamazeStatusAutoResolved:    "auto_resolved",
+	ReamazeStatusChatbotAssigned: "chatbot_assigned",
+	ReamazeStatusChatbotResolved: "chatbot_resolved",
+}
+
+var reamazeOriginNames = map[ReamazeOrigin]string{
+	ReamazeOriginChat:          "chat",
+	ReamazeOriginEmail:         "email",
+	ReamazeOriginTwitter:       "twitter",
+	ReamazeOriginFacebook:      "facebook",
+	ReamazeOriginClassicChat:   "classic_chat",
+	ReamazeOriginAPI:           "api",
+	ReamazeOriginInstagram:     "instagram",
+	ReamazeOriginSMS:           "sms",
+	ReamazeOriginWhatsApp:      "whatsapp",
+	ReamazeOriginStaffOutbound: "staff_outbound",
+	ReamazeOriginContactForm:   "contact_form",
+}
+
+// String returns the name of the status e.g. "pending", unknown statuses are returned as their number so that they can be parsed back
+func (w ReamazeStatus) String() string {
+	return enumString(reamazeStatusNames, w)
+}
+
+// IsValid reports whether the status is one of the ReamazeStatus constants
+func (w ReamazeStatus) IsValid() bool {
+	_, ok := reamazeStatusNames[w]
+	return ok
+}
+
+func (w ReamazeStatus) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *ReamazeStatus) UnmarshalText(text []byte) error {
+	status, err := ParseReamazeStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*w = status
+	return nil
+}
+
+// MarshalJSON encodes the status as the number the API expects
+func (w ReamazeStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(w))), nil
+}
+
+// UnmarshalJSON accepts the number returned by the API as well as the name of the status
+func (w *ReamazeStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, w, ParseReamazeStatus)
+}
+
+// ParseReamazeStatus returns the status with given name e.g. "on_hold", or number e.g. "5"
+func ParseReamazeStatus(name string) (ReamazeStatus, error) {
+	return parseEnum(reamazeStatusNames, "status", name)
+}
+
+// String returns the name of the origin e.g. "email", unknown origins are returned as their number so that they can be parsed back
+func (w ReamazeOrigin) String() string {
+	return enumString(reamazeOriginNames, w)
+}
+
+// IsValid reports whether the origin is one of the ReamazeOrigin constants
+func (w ReamazeOrigin) IsValid() bool {
+	_, ok := reamazeOriginNames[w]
+	return ok
+}
+
+func (w ReamazeOrigin) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *ReamazeOrigin) UnmarshalText(text []byte) error {
+	origin, err := ParseReamazeOrigin(string(text))
+	if err != nil {
+		return err
+	}
+	*w = origin
+	return nil
+}
+
+// MarshalJSON encodes the origin as the number the API expects
+func (w ReamazeOrigin) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(w))), nil
+}
+
+// UnmarshalJSON accepts the number returned by the API as well as the name of the origin
+func (w *ReamazeOrigin) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, w, ParseReamazeOrigin)
+}
+
+// ParseReamazeOrigin returns the origin with given name e.g. "whatsapp", or number e.g. "15"
+func ParseReamazeOrigin(name string) (ReamazeOrigin, error) {
+	return parseEnum(reamazeOriginNames, "origin", name)
+}
+
+func enumString[T ~int](names map[T]string, v T) string {
+	if name, ok := names[v]; ok {
+		return name
+	}
+	return strconv.Itoa(int(v))
+}
+
+// parseEnum looks the value up by name, numbers are accepted as they are so that values unknown to this package round-trip
+func parseEnum[T ~int](names map[T]string, kind string, name string) (T, error) {
+	for v, n := range names {
+		if strings.EqualFold(n, name) {
+			return v, nil
+		}
+	}
+	if n, err := strconv.Atoi(name); err == nil {
+		return T(n), nil
+	}
+	return 0, fmt.Errorf("reamaze: unknown %s %q", kind, name)
+}
+
+func unmarshalEnumJSON[T ~int](data []byte, v *T, parse func(string) (T, error)) error {
+	// checking if it's null, it leaves the value unchanged like for plain numbers
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return err
+		}
+		parsed, err := parse(name)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	}
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*v = T(n)
+	return nil
+}
@@ -0,0 +1,164 @@
+package reamaze
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestReamazeStatus_Text(t *testing.T) {
+	tests := []struct {
+		name   string
+		status ReamazeStatus
+		want   string
+		valid  bool
+	}{
+		{name: "Testing unresolved status", status: ReamazeStatusUnresolved, want: "unresolved", valid: true},
+		{name: "Testing on hold status", status: ReamazeStatusOnHold, want: "on_hold", valid: true},
+		{name: "Testing chatbot resolved status", status: ReamazeStatusChatbotResolved, want: "chatbot_resolved", valid: true},
+		{name: "Testing unknown status", status: ReamazeStatus(42), want: "42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.String(); got != tt.want {
+				t.Errorf("ReamazeStatus.String() = %v, want %v", got, tt.want)
+			}
+			if got := tt.status.IsValid(); got != tt.valid {
+				t.Errorf("ReamazeStatus.IsValid() = %v, want %v", got, tt.valid)
+			}
+			text, err := tt.status.MarshalText()
+			if err != nil {
+				t.Fatalf("ReamazeStatus.MarshalText() error = %v", err)
+			}
+			var parsed ReamazeStatus
+			if err := parsed.UnmarshalText(text); err != nil || parsed != tt.status {
+				t.Errorf("ReamazeStatus.UnmarshalText(%s) = %v, error = %v, want %v", text, parsed, err, tt.status)
+			}
+		})
+	}
+}
+
+func TestParseReamazeStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    ReamazeStatus
+		wantErr bool
+	}{
+		{name: "Testing status name", text: "pending", want: ReamazeStatusPending},
+		{name: "Testing status name in upper case", text: "ARCHIVED", want: ReamazeStatusArchived},
+		{name: "Testing status number", text: "5", want: ReamazeStatusOnHold},
+		{name: "Testing unknown status name", text: "closed", wantErr: true},
+		{name: "Testing empty status", text: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReamazeStatus(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseReamazeStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseReamazeStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReamazeOrigin_Text(t *testing.T) {
+	tests := []struct {
+		name   string
+		origin ReamazeOrigin
+		want   string
+		valid  bool
+	}{
+		{name: "Testing chat origin", origin: ReamazeOriginChat, want: "chat", valid: true},
+		{name: "Testing email origin", origin: ReamazeOriginEmail, want: "email", valid: true},
+		{name: "Testing whatsapp origin", origin: ReamazeOriginWhatsApp, want: "whatsapp", valid: true},
+		{name: "Testing unknown origin", origin: ReamazeOrigin(99), want: "99"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.origin.String(); got != tt.want {
+				t.Errorf("ReamazeOrigin.String() = %v, want %v", got, tt.want)
+			}
+			if got := tt.origin.IsValid(); got != tt.valid {
+				t.Errorf("ReamazeOrigin.IsValid() = %v, want %v", got, tt.valid)
+			}
+			got, err := ParseReamazeOrigin(tt.want)
+			if err != nil || got != tt.origin {
+				t.Errorf("ParseReamazeOrigin(%s) = %v, error = %v, want %v", tt.want, got, err, tt.origin)
+			}
+		})
+	}
+	var origin ReamazeOrigin
+	if err := origin.UnmarshalText([]byte("pigeon")); err == nil {
+		t.Errorf("ReamazeOrigin.UnmarshalText() expected error for unknown origin")
+	}
+}
+
+func TestEnums_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    GetConversationResponse
+		wantErr bool
+	}{
+		{
+			name: "Testing numbers returned by the API",
+			body: `{"status":1,"origin":15}`,
+			want: GetConversationResponse{Status: ReamazeStatusPending, Origin: ReamazeOriginWhatsApp},
+		},
+		{
+			name: "Testing names",
+			body: `{"status":"on_hold","origin":"email"}`,
+			want: GetConversationResponse{Status: ReamazeStatusOnHold, Origin: ReamazeOriginEmail},
+		},
+		{
+			name: "Testing unknown numbers",
+			body: `{"status":42,"origin":99}`,
+			want: GetConversationResponse{Status: ReamazeStatus(42), Origin: ReamazeOrigin(99)},
+		},
+		{
+			name: "Testing null values",
+			body: `{"status":null,"origin":null}`,
+			want: GetConversationResponse{},
+		},
+		{
+			name:    "Testing unknown name",
+			body:    `{"status":"closed"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Testing incorrect type",
+			body:    `{"origin":true}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got GetConversationResponse
+			err := json.Unmarshal([]byte(tt.body), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Status != tt.want.Status || got.Origin != tt.want.Origin {
+				t.Errorf("json.Unmarshal() = %v %v, want %v %v", got.Status, got.Origin, tt.want.Status, tt.want.Origin)
+			}
+		})
+	}
+
+	// requests are sent with numbers whatever the text form is
+	var req UpdateConversationRequest
+	req.Conversation.Status = Ptr(ReamazeStatusResolved)
+	body, err := json.Marshal(req)
+	if err != nil || string(body) != `{"conversation":{"status":2}}` {
+		t.Errorf("json.Marshal() = %s, error = %v", body, err)
+	}
+	body, err = json.Marshal(map[ReamazeStatus]int{ReamazeStatusSpam: 3})
+	if err != nil || string(body) != `{"spam":3}` {
+		t.Errorf("json.Marshal() map = %s, error = %v", body, err)
+	}
+}
@@ -0,0 +1,137 @@
+package reamaze
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors which can be used with errors.Is to check the kind of *APIError returned by the Client
+var (
+	ErrNotFound     = errors.New("reamaze: resource not found")
+	ErrUnauthorized = errors.New("reamaze: unauthorized")
+	ErrRateLimited  = errors.New("reamaze: rate limited")
+	ErrValidation   = errors.New("reamaze: validation failed")
+)
+
+// APIError is returned by the Client whenever re:amaze responds with a non 2xx status code
+type APIError struct {
+	StatusCode         int           // HTTP status code e.g. 404
+	Status             string        // HTTP status line as returned by re:amaze
+	Method             string        // HTTP method of the failed request
+	Endpoint           string        // endpoint of the failed request e.g. /api/v1/contacts/test@example.com
+	Messages           []string      // error messages decoded from the response body
+	Body               []byte        // raw response body
+	RetryAfter         time.Duration // value of the Retry-After header, zero if not present
+	RateLimitLimit     int           // value of the X-RateLimit-Limit header, zero if not present
+	RateLimitRemaining int           // value of the X-RateLimit-Remaining header, zero if not present
+}
+
+func (e *APIError) Error() string {
+	output := "reamaze: " + e.Method + " " + e.Endpoint + ": " + strconv.Itoa(e.StatusCode) + " " + http.StatusText(e.StatusCode)
+	if len(e.Messages) > 0 {
+		output += ": " + strings.Join(e.Messages, "; ")
+	}
+	return output
+}
+
+// Is allows matching *APIError against ErrNotFound, ErrUnauthorized, ErrRateLimited and ErrValidation using errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	default:
+		return false
+	}
+}
+
+// newAPIError builds *APIError from the re:amaze response and its already read body
+func newAPIError(method string, endpoint string, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Method:     method,
+		Endpoint:   endpoint,
+		Messages:   parseErrorMessages(body),
+		Body:       body,
+	}
+	if res.Header != nil {
+		apiErr.RetryAfter = parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+		apiErr.RateLimitLimit, _ = strconv.Atoi(res.Header.Get("X-RateLimit-Limit"))
+		apiErr.RateLimitRemaining, _ = strconv.Atoi(res.Header.Get("X-RateLimit-Remaining"))
+	}
+	return apiErr
+}
+
+// parseRetryAfter parses Retry-After header which can be either number of seconds or HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// parseErrorMessages extracts error messages from re:amaze error response.
+// re:amaze returns errors as {"error":"..."}, {"errors":["..."]} or {"errors":{"field":["..."]}}
+func parseErrorMessages(body []byte) []string {
+	var payload map[string]any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	var messages []string
+	for _, key := range []string{"error", "message", "errors"} {
+		if value, ok := payload[key]; ok {
+			messages = append(messages, flattenErrorMessages("", value)...)
+		}
+	}
+	return messages
+}
+
+func flattenErrorMessages(prefix string, value any) []string {
+	var messages []string
+	switch v := value.(type) {
+	case string:
+		if len(prefix) > 0 {
+			return []string{prefix + " " + v}
+		}
+		return []string{v}
+	case []any:
+		for _, item := range v {
+			messages = append(messages, flattenErrorMessages(prefix, item)...)
+		}
+	case map[string]any:
+		// sorting keys so that messages are always in the same order
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			messages = append(messages, flattenErrorMessages(k, v[k])...)
+		}
+	case nil:
+	default:
+		messages = append(messages, strings.TrimSpace(prefix+" "+fmt.Sprint(v)))
+	}
+	return messages
+}
@@ -0,0 +1,123 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		target     error
+		want       bool
+	}{
+		{name: "Testing 404 is ErrNotFound", statusCode: http.StatusNotFound, target: ErrNotFound, want: true},
+		{name: "Testing 401 is ErrUnauthorized", statusCode: http.StatusUnauthorized, target: ErrUnauthorized, want: true},
+		{name: "Testing 403 is ErrUnauthorized", statusCode: http.StatusForbidden, target: ErrUnauthorized, want: true},
+		{name: "Testing 429 is ErrRateLimited", statusCode: http.StatusTooManyRequests, target: ErrRateLimited, want: true},
+		{name: "Testing 422 is ErrValidation", statusCode: http.StatusUnprocessableEntity, target: ErrValidation, want: true},
+		{name: "Testing 400 is ErrValidation", statusCode: http.StatusBadRequest, target: ErrValidation, want: true},
+		{name: "Testing 404 is not ErrValidation", statusCode: http.StatusNotFound, target: ErrValidation, want: false},
+		{name: "Testing 500 is not ErrNotFound", statusCode: http.StatusInternalServerError, target: ErrNotFound, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error = &APIError{StatusCode: tt.statusCode}
+			if got := errors.Is(err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusUnprocessableEntity, Method: http.MethodPost, Endpoint: "/api/v1/conversations", Messages: []string{"category is invalid"}}
+	want := "reamaze: POST /api/v1/conversations: 422 Unprocessable Entity: category is invalid"
+	if got := err.Error(); got != want {
+		t.Errorf("APIError.Error() = %v, want %v", got, want)
+	}
+}
+
+func Test_parseErrorMessages(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{name: "Testing error string", body: `{"error":"Not Found"}`, want: []string{"Not Found"}},
+		{name: "Testing errors list", body: `{"errors":["first","second"]}`, want: []string{"first", "second"}},
+		{name: "Testing errors map", body: `{"errors":{"email":["is invalid"],"category":["can't be blank"]}}`, want: []string{"category can't be blank", "email is invalid"}},
+		{name: "Testing invalid JSON", body: `Not Found`, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseErrorMessages([]byte(tt.body)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseErrorMessages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "Testing empty header", value: "", want: 0},
+		{name: "Testing seconds", value: "120", want: 2 * time.Minute},
+		{name: "Testing HTTP date", value: "Mon, 01 Jan 2024 00:00:30 GMT", want: 30 * time.Second},
+		{name: "Testing date in the past", value: "Sun, 31 Dec 2023 00:00:00 GMT", want: 0},
+		{name: "Testing invalid value", value: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("parseRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_reamazeRequestAPIError(t *testing.T) {
+	c := &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Status:     "429 Too Many Requests",
+				Header:     http.Header{"Retry-After": []string{"10"}, "X-Ratelimit-Limit": []string{"100"}, "X-Ratelimit-Remaining": []string{"0"}},
+				Body:       io.NopCloser(strings.NewReader(`{"error":"Rate limit exceeded"}`)),
+			}
+		}),
+	}}
+	_, err := c.reamazeRequest(context.Background(), http.MethodGet, "/api/v1/contacts", []byte{})
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Client.reamazeRequest() error = %v, want %v", err, ErrRateLimited)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Client.reamazeRequest() error is not *APIError")
+	}
+	want := &APIError{
+		StatusCode:         http.StatusTooManyRequests,
+		Status:             "429 Too Many Requests",
+		Method:             http.MethodGet,
+		Endpoint:           "/api/v1/contacts",
+		Messages:           []string{"Rate limit exceeded"},
+		Body:               []byte(`{"error":"Rate limit exceeded"}`),
+		RetryAfter:         10 * time.Second,
+		RateLimitLimit:     100,
+		RateLimitRemaining: 0,
+	}
+	if !reflect.DeepEqual(apiErr, want) {
+		t.Errorf("Client.reamazeRequest() error = %#v, want %#v", apiErr, want)
+	}
+}
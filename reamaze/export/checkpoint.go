@@ -0,0 +1,70 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// Checkpoint is the progress of the export saved after every page
+type Checkpoint struct {
+	// WindowStart is the first day (YYYY-MM-DD) of the date window being exported
+	WindowStart string `json:"window_start"`
+	// Page is the last page of the window which has been written
+	Page int `json:"page"`
+	// Conversations and Messages are the numbers of items written so far
+	Conversations int `json:"conversations"`
+	Messages      int `json:"messages"`
+	// Offset is the number of bytes of the output after the last written page, see OpenFile
+	Offset int64 `json:"offset"`
+	// Completed is set when all windows have been exported
+	Completed bool `json:"completed"`
+}
+
+// CheckpointStore persists Checkpoint between runs of the export
+type CheckpointStore interface {
+	// Load returns the saved Checkpoint, or nil when there is none
+	Load() (*Checkpoint, error)
+	Save(checkpoint Checkpoint) error
+}
+
+// FileCheckpointStore keeps Checkpoint as JSON in a file
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore returns CheckpointStore keeping Checkpoint in the file at path
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load returns Checkpoint read from the file, or nil when the file doesn't exist
+func (s *FileCheckpointStore) Load() (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// Save replaces the file with checkpoint, the file is written to a temporary file first
+// so that it's never left half written
+func (s *FileCheckpointStore) Save(checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,209 @@
+// Package export dumps conversations of a Brand together with their messages, e.g. for compliance archives.
+//
+// Conversations are walked in date windows starting from the oldest one, page by page, every conversation is
+// written as a Record with all its messages. A Checkpoint is saved after every page, so that an export
+// interrupted by a failure can be resumed by running it again with the same CheckpointStore.
+// The output has to be truncated to the size saved in Checkpoint before resuming, OpenFile does it for files.
+//
+//	store := export.NewFileCheckpointStore("export.checkpoint")
+//	checkpoint, err := store.Load()
+//	...
+//	f, err := export.OpenFile("export.jsonl", checkpoint)
+//	...
+//	exporter := export.New(client, export.NewJSONLWriter(f), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+//		export.WithCheckpointStore(store))
+//	if err := exporter.Run(ctx); err != nil {
+//		// run it again to resume
+//	}
+//
+// Records are written exactly once, the part of the page being exported when the failure happened is dropped
+// by the truncation and the whole page is written again after resuming.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// dateLayout is the format of dates in Checkpoint and date filters of the API
+const dateLayout = "2006-01-02"
+
+// DefaultWindowDays is the number of days of conversations requested at once when WithWindowDays isn't used
+const DefaultWindowDays = 30
+
+// Source is the part of reamaze.API used by Exporter, *reamaze.Client implements it
+type Source interface {
+	reamaze.ConversationsService
+	reamaze.MessagesService
+}
+
+// Record is a single conversation together with all its messages in chronological order
+type Record struct {
+	Conversation reamaze.GetConversationResponse `json:"conversation"`
+	Messages     []reamaze.Message               `json:"messages"`
+}
+
+// Writer receives exported records, Flush is called after every page before the Checkpoint is saved
+type Writer interface {
+	Write(r *Record) error
+	Flush() error
+	// Written returns the number of bytes flushed to the output since the Writer was created
+	Written() int64
+}
+
+// Exporter walks conversations created between start and end date and writes them to Writer, see New
+type Exporter struct {
+	src      Source
+	w        Writer
+	start    time.Time
+	settings *Options
+}
+
+// New returns Exporter of conversations created since start (the date part is used), optional parameters are
+// WithEndDate(time.Time), WithWindowDays(int), WithCheckpointStore(CheckpointStore),
+// WithConversationsOptions(...reamaze.ConversationsOption) and WithProgress(func(Checkpoint))
+func New(src Source, w Writer, start time.Time, opts ...Option) *Exporter {
+	return &Exporter{src: src, w: w, start: start, settings: newSettings(opts)}
+}
+
+// Run exports all conversations, resuming from the Checkpoint loaded from CheckpointStore when it's set.
+// It returns immediately when the loaded Checkpoint is completed, delete it to export again.
+func (e *Exporter) Run(ctx context.Context) error {
+	// checking if we have start date
+	if e.start.IsZero() {
+		return errors.New("export start date cannot be empty, please provide start date as argument to New")
+	}
+	first := day(e.start)
+	last := day(time.Now())
+	if !e.settings.End.IsZero() {
+		last = day(e.settings.End)
+	}
+	if last.Before(first) {
+		return fmt.Errorf("export end date %s is before start date %s", last.Format(dateLayout), first.Format(dateLayout))
+	}
+
+	checkpoint := Checkpoint{WindowStart: first.Format(dateLayout)}
+	if e.settings.Checkpoints != nil {
+		loaded, err := e.settings.Checkpoints.Load()
+		if err != nil {
+			return err
+		}
+		if loaded != nil {
+			checkpoint = *loaded
+		}
+	}
+	if checkpoint.Completed {
+		return nil
+	}
+	windowStart, err := time.Parse(dateLayout, checkpoint.WindowStart)
+	if err != nil {
+		return fmt.Errorf("export checkpoint has invalid window start: %w", err)
+	}
+	if windowStart.Before(first) || windowStart.After(last) {
+		return fmt.Errorf("export checkpoint window %s is outside of exported dates %s - %s", checkpoint.WindowStart, first.Format(dateLayout), last.Format(dateLayout))
+	}
+
+	for !windowStart.After(last) {
+		windowEnd := windowStart.AddDate(0, 0, e.settings.WindowDays-1)
+		if windowEnd.After(last) {
+			windowEnd = last
+		}
+		if err := e.exportWindow(ctx, windowStart, windowEnd, &checkpoint); err != nil {
+			return err
+		}
+		windowStart = windowEnd.AddDate(0, 0, 1)
+		checkpoint.WindowStart = windowStart.Format(dateLayout)
+		checkpoint.Page = 0
+		if windowStart.After(last) {
+			checkpoint.Completed = true
+		}
+		if err := e.save(checkpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportWindow exports pages of conversations created between start and end following checkpoint.Page
+func (e *Exporter) exportWindow(ctx context.Context, start, end time.Time, checkpoint *Checkpoint) error {
+	opts := append([]reamaze.ConversationsOption{reamaze.WithFilter(reamaze.ReamazeFilterAll)}, e.settings.ConversationsOptions...)
+	// pages are only resumable when the order of conversations doesn't change between runs
+	opts = append(opts,
+		reamaze.WithSort(reamaze.ReamazeSortCreatedAt),
+		reamaze.WithStartDate(start.Year(), int(start.Month()), start.Day()),
+		reamaze.WithEndDate(end.Year(), int(end.Month()), end.Day()),
+	)
+	// offset is the size of the output when the Writer was created, the output is truncated to the saved Offset when resuming
+	offset := checkpoint.Offset - e.w.Written()
+	for page := checkpoint.Page + 1; ; page++ {
+		pageOpts := append(append([]reamaze.ConversationsOption{}, opts...), reamaze.WithPage(page))
+		resp, err := e.src.GetConversationsWithContext(ctx, pageOpts...)
+		if err != nil {
+			return err
+		}
+		for _, conversation := range resp.Conversations {
+			messages, err := e.messages(ctx, conversation.Slug)
+			if err != nil {
+				return err
+			}
+			if err := e.w.Write(&Record{Conversation: conversation, Messages: messages}); err != nil {
+				return err
+			}
+			checkpoint.Conversations++
+			checkpoint.Messages += len(messages)
+		}
+		if err := e.w.Flush(); err != nil {
+			return err
+		}
+		checkpoint.Page = page
+		checkpoint.Offset = offset + e.w.Written()
+		if err := e.save(*checkpoint); err != nil {
+			return err
+		}
+		if len(resp.Conversations) == 0 || page >= resp.PageCount {
+			return nil
+		}
+	}
+}
+
+// messages returns all messages of the conversation in chronological order
+func (e *Exporter) messages(ctx context.Context, slug string) ([]reamaze.Message, error) {
+	messages := []reamaze.Message{}
+	for page := 1; ; page++ {
+		resp, err := e.src.GetConversationMessagesWithContext(ctx, slug, reamaze.WithMessagesPage(page))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, resp.Messages...)
+		if len(resp.Messages) == 0 || page >= resp.PageCount {
+			break
+		}
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+	})
+	return messages, nil
+}
+
+// save saves checkpoint to the store and reports progress, when they are set
+func (e *Exporter) save(checkpoint Checkpoint) error {
+	if e.settings.Checkpoints != nil {
+		if err := e.settings.Checkpoints.Save(checkpoint); err != nil {
+			return err
+		}
+	}
+	if e.settings.Progress != nil {
+		e.settings.Progress(checkpoint)
+	}
+	return nil
+}
+
+// day returns midnight UTC of the date of t
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
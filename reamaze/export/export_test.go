@@ -0,0 +1,340 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+	"github.com/meant4/reamaze-go/reamaze/reamazetest"
+)
+
+// newTestServer returns reamazetest.Server with a conversation created on each of the days, replied to by staff
+func newTestServer(t *testing.T, days ...time.Time) (*reamazetest.Server, *reamaze.Client) {
+	t.Helper()
+	srv := reamazetest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.PageSize = 2
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("Server.NewClient() error = %v", err)
+	}
+	for _, d := range days {
+		d := d
+		srv.SetClock(func() time.Time { return d })
+		req := &reamaze.CreateConversationRequest{}
+		req.Conversation.Subject = "Conversation of " + d.Format(dateLayout)
+		req.Conversation.Category = "support"
+		req.Conversation.Message.Body = "Question"
+		req.Conversation.User = reamaze.User{Email: "john@example.com"}
+		conversation, err := client.CreateConversation(req)
+		if err != nil {
+			t.Fatalf("CreateConversation() error = %v", err)
+		}
+		if _, err := client.CreateMessage(conversation.Slug, &reamaze.CreateMessageRequest{Message: reamaze.MessageRequest{Body: "Answer"}}); err != nil {
+			t.Fatalf("CreateMessage() error = %v", err)
+		}
+	}
+	return srv, client
+}
+
+// memoryCheckpointStore keeps Checkpoint in memory
+type memoryCheckpointStore struct {
+	checkpoint *Checkpoint
+}
+
+func (m *memoryCheckpointStore) Load() (*Checkpoint, error) {
+	return m.checkpoint, nil
+}
+
+func (m *memoryCheckpointStore) Save(checkpoint Checkpoint) error {
+	m.checkpoint = &checkpoint
+	return nil
+}
+
+// failingWriter returns error instead of writing the record number failAt
+type failingWriter struct {
+	Writer
+	written int
+	failAt  int
+}
+
+func (f *failingWriter) Write(r *Record) error {
+	f.written++
+	if f.written == f.failAt {
+		return errors.New("disk full")
+	}
+	return f.Writer.Write(r)
+}
+
+func decodeRecords(t *testing.T, data []byte) []Record {
+	t.Helper()
+	var records []Record
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestExporter_Resume(t *testing.T) {
+	day1 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	days := []time.Time{day1, day1.Add(time.Hour), day1.Add(2 * time.Hour), day1.AddDate(0, 0, 1), day1.AddDate(0, 0, 4)}
+	_, client := newTestServer(t, days...)
+	store := &memoryCheckpointStore{}
+	var out bytes.Buffer
+	var progress []Checkpoint
+	opts := []Option{
+		WithEndDate(day1.AddDate(0, 0, 5)),
+		WithWindowDays(2),
+		WithCheckpointStore(store),
+		WithProgress(func(c Checkpoint) { progress = append(progress, c) }),
+	}
+
+	// third conversation is on the second page of the first window
+	err := New(client, &failingWriter{Writer: NewJSONLWriter(&out), failAt: 3}, day1, opts...).Run(context.Background())
+	if err == nil || err.Error() != "disk full" {
+		t.Fatalf("Run() error = %v, want disk full", err)
+	}
+	if want := (Checkpoint{WindowStart: "2024-01-01", Page: 1, Conversations: 2, Messages: 4, Offset: int64(out.Len())}); !reflect.DeepEqual(*store.checkpoint, want) {
+		t.Errorf("checkpoint = %+v, want %+v", *store.checkpoint, want)
+	}
+
+	out.Truncate(int(store.checkpoint.Offset))
+	if err := New(client, NewJSONLWriter(&out), day1, opts...).Run(context.Background()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if want := (Checkpoint{WindowStart: "2024-01-07", Conversations: 5, Messages: 10, Offset: int64(out.Len()), Completed: true}); !reflect.DeepEqual(*store.checkpoint, want) {
+		t.Errorf("checkpoint = %+v, want %+v", *store.checkpoint, want)
+	}
+	records := decodeRecords(t, out.Bytes())
+	if len(records) != len(days) {
+		t.Fatalf("exported %d records, want %d", len(records), len(days))
+	}
+	exported := map[string]bool{}
+	for _, r := range records {
+		exported[r.Conversation.Subject] = true
+		if len(r.Messages) != 2 || r.Messages[0].Body != "Question" || r.Messages[1].Body != "Answer" {
+			t.Errorf("messages of %s = %+v", r.Conversation.Slug, r.Messages)
+		}
+	}
+	for _, d := range days {
+		if !exported["Conversation of "+d.Format(dateLayout)] {
+			t.Errorf("conversation of %s wasn't exported", d)
+		}
+	}
+	if last := progress[len(progress)-1]; !last.Completed {
+		t.Errorf("last progress = %+v, want completed", last)
+	}
+
+	// completed export isn't repeated
+	out.Reset()
+	if err := New(client, NewJSONLWriter(&out), day1, opts...).Run(context.Background()); err != nil || out.Len() > 0 {
+		t.Errorf("Run() error = %v, output %s", err, out.String())
+	}
+}
+
+// failingSource returns error instead of messages of the conversation number failAt
+type failingSource struct {
+	Source
+	fetched int
+	failAt  int
+}
+
+func (f *failingSource) GetConversationMessagesWithContext(ctx context.Context, slug string, opts ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error) {
+	f.fetched++
+	if f.fetched == f.failAt {
+		return nil, errors.New("connection reset")
+	}
+	return f.Source.GetConversationMessagesWithContext(ctx, slug, opts...)
+}
+
+func TestExporter_ResumeFile(t *testing.T) {
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	srv := reamazetest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.PageSize = 3
+	srv.SetClock(func() time.Time { return created })
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("Server.NewClient() error = %v", err)
+	}
+	// records are larger than the buffer of the writers, so that they are flushed in parts
+	body := strings.Repeat("Lorem ipsum, \"dolor\" sit amet\n", 50)
+	for i := 0; i < 6; i++ {
+		req := &reamaze.CreateConversationRequest{}
+		req.Conversation.Subject = fmt.Sprintf("Conversation %d", i)
+		req.Conversation.Category = "support"
+		req.Conversation.Message.Body = body
+		req.Conversation.User = reamaze.User{Email: "john@example.com"}
+		conversation, err := client.CreateConversation(req)
+		if err != nil {
+			t.Fatalf("CreateConversation() error = %v", err)
+		}
+		if _, err := client.CreateMessage(conversation.Slug, &reamaze.CreateMessageRequest{Message: reamaze.MessageRequest{Body: body}}); err != nil {
+			t.Fatalf("CreateMessage() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		newWriter func(f *os.File) Writer
+		count     func(t *testing.T, data []byte) int
+	}{
+		{
+			name:      "Testing JSON lines",
+			newWriter: func(f *os.File) Writer { return NewJSONLWriter(f) },
+			count:     func(t *testing.T, data []byte) int { return len(decodeRecords(t, data)) },
+		},
+		{
+			name: "Testing CSV",
+			newWriter: func(f *os.File) Writer {
+				info, err := f.Stat()
+				if err != nil {
+					t.Fatalf("Stat() error = %v", err)
+				}
+				return NewCSVWriter(f, info.Size() == 0)
+			},
+			count: func(t *testing.T, data []byte) int {
+				rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+				if err != nil {
+					t.Fatalf("csv.ReadAll() error = %v", err)
+				}
+				// header and a row per message
+				return (len(rows) - 1) / 2
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "export")
+			store := &memoryCheckpointStore{}
+			run := func(src Source) error {
+				f, err := OpenFile(path, store.checkpoint)
+				if err != nil {
+					t.Fatalf("OpenFile() error = %v", err)
+				}
+				defer f.Close()
+				return New(src, tt.newWriter(f), created, WithEndDate(created), WithCheckpointStore(store)).Run(context.Background())
+			}
+
+			// the last conversation of the second page fails after a part of the page reached the file
+			if err := run(&failingSource{Source: client, failAt: 6}); err == nil {
+				t.Fatalf("Run() expected error")
+			}
+			if store.checkpoint == nil || store.checkpoint.Page != 1 {
+				t.Fatalf("checkpoint = %+v, want first page", store.checkpoint)
+			}
+			if info, err := os.Stat(path); err != nil || info.Size() <= store.checkpoint.Offset {
+				t.Fatalf("file size = %v, error = %v, want partially written page after offset %d", info.Size(), err, store.checkpoint.Offset)
+			}
+
+			if err := run(client); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("os.ReadFile() error = %v", err)
+			}
+			if got := tt.count(t, data); got != 6 || store.checkpoint.Conversations != 6 {
+				t.Errorf("exported %d records, checkpoint %+v, want 6", got, store.checkpoint)
+			}
+			if store.checkpoint.Offset != int64(len(data)) {
+				t.Errorf("checkpoint offset = %d, want %d", store.checkpoint.Offset, len(data))
+			}
+		})
+	}
+}
+
+func TestExporter_Errors(t *testing.T) {
+	_, client := newTestServer(t)
+	start := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		start      time.Time
+		opts       []Option
+		checkpoint *Checkpoint
+	}{
+		{name: "Testing empty start date", start: time.Time{}},
+		{name: "Testing end date before start date", start: start, opts: []Option{WithEndDate(start.AddDate(0, 0, -1))}},
+		{name: "Testing checkpoint outside of exported dates", start: start, checkpoint: &Checkpoint{WindowStart: "2024-01-01"}},
+		{name: "Testing invalid checkpoint", start: start, checkpoint: &Checkpoint{WindowStart: "dummy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append(tt.opts, WithCheckpointStore(&memoryCheckpointStore{checkpoint: tt.checkpoint}))
+			if err := New(client, NewJSONLWriter(&bytes.Buffer{}), tt.start, opts...).Run(context.Background()); err == nil {
+				t.Errorf("Run() expected error")
+			}
+		})
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	records := []*Record{
+		{
+			Conversation: reamaze.GetConversationResponse{Slug: "order", Subject: "Order", Status: reamaze.ReamazeStatusResolved, TagList: []string{"a", "b"}, CreatedAt: created},
+			Messages: []reamaze.Message{
+				{Body: "Hello, world", Origin: reamaze.ReamazeOriginEmail, CreatedAt: created, User: reamaze.User{Email: "john@example.com", Name: "John"}},
+				{Body: "Note", Visibility: 1, Attachments: []reamaze.Attachment{{URL: "https://example.com/a.png"}, {URL: "https://example.com/b.png"}}},
+			},
+		},
+		{Conversation: reamaze.GetConversationResponse{Slug: "empty"}},
+	}
+	var out bytes.Buffer
+	w := NewCSVWriter(&out, true)
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	got, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll() error = %v", err)
+	}
+	want := [][]string{
+		CSVHeader,
+		{"order", "Order", "resolved", "", "a,b", "", "2024-01-01T10:00:00Z", "2024-01-01T10:00:00Z", "john@example.com", "John", "0", "email", "Hello, world", ""},
+		{"order", "Order", "resolved", "", "a,b", "", "2024-01-01T10:00:00Z", "", "", "", "1", "chat", "Note", "https://example.com/a.png https://example.com/b.png"},
+		{"empty", "", "unresolved", "", "", "", "", "", "", "", "", "", "", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CSVWriter wrote %v, want %v", got, want)
+	}
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	checkpoint, err := store.Load()
+	if err != nil || checkpoint != nil {
+		t.Fatalf("Load() = %v, error = %v, want nil", checkpoint, err)
+	}
+	want := Checkpoint{WindowStart: "2024-01-01", Page: 3, Conversations: 90, Messages: 400}
+	if err := store.Save(want); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	checkpoint, err = store.Load()
+	if err != nil || !reflect.DeepEqual(*checkpoint, want) {
+		t.Errorf("Load() = %+v, error = %v, want %+v", checkpoint, err, want)
+	}
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+type EndDate time.Time
+type WindowDays int
+type ConversationsOptions []reamaze.ConversationsOption
+type ProgressFunc func(Checkpoint)
+
+// CheckpointStoreOption is the option returned by WithCheckpointStore
+type CheckpointStoreOption struct {
+	Store CheckpointStore
+}
+
+// Option configures the Exporter created by New
+type Option interface {
+	Apply(*Options)
+}
+
+type Options struct {
+	End                  time.Time
+	WindowDays           int
+	Checkpoints          CheckpointStore
+	ConversationsOptions []reamaze.ConversationsOption
+	Progress             func(Checkpoint)
+}
+
+func (w EndDate) Apply(o *Options) {
+	o.End = time.Time(w)
+}
+
+func (w WindowDays) Apply(o *Options) {
+	if w > 0 {
+		o.WindowDays = int(w)
+	}
+}
+
+func (w CheckpointStoreOption) Apply(o *Options) {
+	o.Checkpoints = w.Store
+}
+
+func (w ConversationsOptions) Apply(o *Options) {
+	o.ConversationsOptions = append(o.ConversationsOptions, w...)
+}
+
+func (w ProgressFunc) Apply(o *Options) {
+	o.Progress = w
+}
+
+// WithEndDate sets the last day of exported conversations, today by default
+func WithEndDate(end time.Time) EndDate {
+	return EndDate(end)
+}
+
+// WithWindowDays sets the number of days of conversations requested at once, DefaultWindowDays by default
+func WithWindowDays(days int) WindowDays {
+	return WindowDays(days)
+}
+
+// WithCheckpointStore sets the store the progress is saved to after every page, and resumed from by Run
+func WithCheckpointStore(store CheckpointStore) CheckpointStoreOption {
+	return CheckpointStoreOption{Store: store}
+}
+
+// WithConversationsOptions narrows down exported conversations e.g. reamaze.WithCategory("support"),
+// all conversations are exported by default. Date and page options are overridden by the Exporter.
+func WithConversationsOptions(o ...reamaze.ConversationsOption) ConversationsOptions {
+	return ConversationsOptions(o)
+}
+
+// WithProgress sets the function called with the Checkpoint after every page
+func WithProgress(progress func(Checkpoint)) ProgressFunc {
+	return ProgressFunc(progress)
+}
+
+func newSettings(opts []Option) *Options {
+	o := Options{WindowDays: DefaultWindowDays}
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	return &o
+}
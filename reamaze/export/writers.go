@@ -0,0 +1,139 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OpenFile opens the output file of the export for appending. The file is truncated to checkpoint.Offset,
+// dropping whatever was written after the last saved Checkpoint, or emptied when checkpoint is nil.
+func OpenFile(name string, checkpoint *Checkpoint) (*os.File, error) {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	var offset int64
+	if checkpoint != nil {
+		offset = checkpoint.Offset
+	}
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// countingWriter counts bytes written to w
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// JSONLWriter writes every Record as a single line of JSON
+type JSONLWriter struct {
+	out *countingWriter
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONLWriter returns Writer writing JSON lines to w
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	out := &countingWriter{w: w}
+	buffered := bufio.NewWriter(out)
+	return &JSONLWriter{out: out, w: buffered, enc: json.NewEncoder(buffered)}
+}
+
+func (j *JSONLWriter) Write(r *Record) error {
+	return j.enc.Encode(r)
+}
+
+func (j *JSONLWriter) Flush() error {
+	return j.w.Flush()
+}
+
+func (j *JSONLWriter) Written() int64 {
+	return j.out.n
+}
+
+// CSVHeader lists columns written by CSVWriter
+var CSVHeader = []string{
+	"conversation_slug", "conversation_subject", "conversation_status", "conversation_category", "conversation_tags",
+	"conversation_author_email", "conversation_created_at",
+	"message_created_at", "message_author_email", "message_author_name", "message_visibility", "message_origin",
+	"message_body", "message_attachments",
+}
+
+// CSVWriter writes a row per message with the conversation columns repeated,
+// conversations without messages are written as a single row with empty message columns
+type CSVWriter struct {
+	out    *countingWriter
+	w      *csv.Writer
+	header bool
+}
+
+// NewCSVWriter returns Writer writing CSV to w, CSVHeader is written before the first record when header is true.
+// Pass false when appending to the file of a resumed export.
+func NewCSVWriter(w io.Writer, header bool) *CSVWriter {
+	out := &countingWriter{w: w}
+	return &CSVWriter{out: out, w: csv.NewWriter(out), header: header}
+}
+
+func (c *CSVWriter) Write(r *Record) error {
+	if c.header {
+		if err := c.w.Write(CSVHeader); err != nil {
+			return err
+		}
+		c.header = false
+	}
+	conv := r.Conversation
+	conversation := []string{
+		conv.Slug, conv.Subject, conv.Status.String(), conv.Category.Slug, strings.Join(conv.TagList, ","),
+		conv.Author.Email, formatTime(conv.CreatedAt),
+	}
+	if len(r.Messages) == 0 {
+		return c.w.Write(append(conversation, make([]string, len(CSVHeader)-len(conversation))...))
+	}
+	for _, m := range r.Messages {
+		attachments := make([]string, len(m.Attachments))
+		for i, a := range m.Attachments {
+			attachments[i] = a.URL
+		}
+		row := append(append([]string{}, conversation...),
+			formatTime(m.CreatedAt), m.User.Email, m.User.Name, strconv.Itoa(m.Visibility), m.Origin.String(),
+			m.Body, strings.Join(attachments, " "),
+		)
+		if err := c.w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CSVWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *CSVWriter) Written() int64 {
+	return c.out.n
+}
+
+// formatTime returns t in RFC 3339 format, or empty string for zero time
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
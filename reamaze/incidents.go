@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -11,9 +12,14 @@ import (
 // GetIncidents will allow you to retrieve the incidents for the Brand
 // https://www.reamaze.com/api/get_incidents
 func (c *Client) GetIncidents() (*GetIncidentsResponse, error) {
+	return c.GetIncidentsWithContext(context.Background())
+}
+
+// GetIncidentsWithContext is like GetIncidents but uses ctx for the underlying request.
+func (c *Client) GetIncidentsWithContext(ctx context.Context) (*GetIncidentsResponse, error) {
 	var response *GetIncidentsResponse
 	urlEndpoint := incidentsEndpoint
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -28,6 +34,11 @@ func (c *Client) GetIncidents() (*GetIncidentsResponse, error) {
 // GetIncidents will allow you to retrieve the incidents for the Brand
 // https://www.reamaze.com/api/get_incident
 func (c *Client) GetIncident(identifier string) (*GetIncidentResponse, error) {
+	return c.GetIncidentWithContext(context.Background(), identifier)
+}
+
+// GetIncidentWithContext is like GetIncident but uses ctx for the underlying request.
+func (c *Client) GetIncidentWithContext(ctx context.Context, identifier string) (*GetIncidentResponse, error) {
 	var response *GetIncidentResponse
 
 	if len(identifier) == 0 {
@@ -35,7 +46,7 @@ func (c *Client) GetIncident(identifier string) (*GetIncidentResponse, error) {
 	}
 	urlEndpoint := incidentsEndpoint + "/" + url.PathEscape(identifier)
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +61,11 @@ func (c *Client) GetIncident(identifier string) (*GetIncidentResponse, error) {
 // UpdateIncident will allow you to retrieve the incidents for the Brand
 // https://www.reamaze.com/api/put_incident
 func (c *Client) UpdateIncident(identifier string, req *UpdateIncidentRequest) (*UpdateIncidentResponse, error) {
+	return c.UpdateIncidentWithContext(context.Background(), identifier, req)
+}
+
+// UpdateIncidentWithContext is like UpdateIncident but uses ctx for the underlying request.
+func (c *Client) UpdateIncidentWithContext(ctx context.Context, identifier string, req *UpdateIncidentRequest) (*UpdateIncidentResponse, error) {
 	var response *UpdateIncidentResponse
 	emptyReq := &UpdateIncidentRequest{}
 	// checking if we don't have empty request
@@ -62,7 +78,7 @@ func (c *Client) UpdateIncident(identifier string, req *UpdateIncidentRequest) (
 
 	urlEndpoint := incidentsEndpoint + "/" + url.PathEscape(identifier)
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPut, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPut, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +96,11 @@ func (c *Client) UpdateIncident(identifier string, req *UpdateIncidentRequest) (
 // The status attribute in updates_attributes can be one of the following: [investigating, identified, monitoring, resolved]
 // The status attribute in incidents_systems_attributes can be one of the following: [operational, degraded_performance, partial_outage, major_outage, under_maintenance]
 func (c *Client) CreateIncident(req *CreateIncidentRequest) (*CreateIncidentResponse, error) {
+	return c.CreateIncidentWithContext(context.Background(), req)
+}
+
+// CreateIncidentWithContext is like CreateIncident but uses ctx for the underlying request.
+func (c *Client) CreateIncidentWithContext(ctx context.Context, req *CreateIncidentRequest) (*CreateIncidentResponse, error) {
 	var response *CreateIncidentResponse
 	emptyReq := &CreateIncidentRequest{}
 	// checking if we don't have empty request
@@ -89,7 +110,7 @@ func (c *Client) CreateIncident(req *CreateIncidentRequest) (*CreateIncidentResp
 
 	urlEndpoint := incidentsEndpoint
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,238 @@
+// Command genmock generates reamazemock.Mock implementing every method of the service interfaces declared in api.go.
+//
+//	go run ./internal/genmock -in api.go -out reamazemock/mock_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"slices"
+	"strings"
+)
+
+const header = `// Code generated by go run ./internal/genmock; DO NOT EDIT.
+
+package reamazemock
+
+`
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+func main() {
+	in := flag.String("in", "api.go", "file declaring the service interfaces")
+	out := flag.String("out", "reamazemock/mock_gen.go", "generated file")
+	flag.Parse()
+
+	src, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := generate(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns source of Mock implementing methods of all interfaces declared in src, in declaration order
+func generate(src []byte) ([]byte, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "api.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := collectMethods(file)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		names[m.name] = true
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	writeImports(&b, file)
+	b.WriteString("// Mock implements reamaze.API, every method calls the function field named after it with Func suffix.\n")
+	b.WriteString("// Methods without context fall back to their WithContext function field called with context.Background(),\n")
+	b.WriteString("// and return ErrNotMocked when neither is set. Every call is recorded, see Calls.\n")
+	b.WriteString("type Mock struct {\n\trecorder\n\n")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\t%sFunc func(%s) (%s)\n", m.name, m.signature(), strings.Join(m.results, ", "))
+	}
+	b.WriteString("}\n")
+
+	for _, m := range methods {
+		fallback := m.name + "WithContext"
+		hasFallback := names[fallback] && !strings.HasSuffix(m.name, "WithContext")
+		if hasFallback {
+			fmt.Fprintf(&b, "\n// %s calls %sFunc, or %sFunc with context.Background() when it's nil\n", m.name, m.name, fallback)
+		} else {
+			fmt.Fprintf(&b, "\n// %s calls %sFunc\n", m.name, m.name)
+		}
+		fmt.Fprintf(&b, "func (m *Mock) %s(%s) (%s) {\n", m.name, m.signature(), strings.Join(m.results, ", "))
+		fmt.Fprintf(&b, "\tm.record(%q%s)\n", m.name, m.recordArgs())
+		fmt.Fprintf(&b, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, m.callArgs())
+		if hasFallback {
+			fmt.Fprintf(&b, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", fallback, fallback, m.fallbackArgs())
+		}
+		fmt.Fprintf(&b, "\treturn nil, ErrNotMocked\n}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// writeImports writes imports of src, used by the parameter types, followed by the reamaze package.
+// context is always imported as methods without context call their WithContext variant.
+func writeImports(b *bytes.Buffer, file *ast.File) {
+	paths := []string{`"context"`}
+	for _, imp := range file.Imports {
+		if !slices.Contains(paths, imp.Path.Value) {
+			paths = append(paths, imp.Path.Value)
+		}
+	}
+	slices.Sort(paths)
+	b.WriteString("import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(b, "\t%s\n", path)
+	}
+	b.WriteString("\n\t\"github.com/meant4/reamaze-go/reamaze\"\n)\n\n")
+}
+
+// collectMethods returns methods declared directly in interfaces, embedded interfaces are skipped as they are declared in the same file
+func collectMethods(file *ast.File) ([]method, error) {
+	var methods []method
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			iface, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+			for _, field := range iface.Methods.List {
+				fn, ok := field.Type.(*ast.FuncType)
+				if !ok {
+					continue
+				}
+				m, err := newMethod(field.Names[0].Name, fn)
+				if err != nil {
+					return nil, err
+				}
+				methods = append(methods, m)
+			}
+		}
+	}
+	return methods, nil
+}
+
+func newMethod(name string, fn *ast.FuncType) (method, error) {
+	m := method{name: name}
+	for _, field := range fn.Params.List {
+		if len(field.Names) == 0 {
+			return m, fmt.Errorf("%s: parameters must be named", name)
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+		p := param{typ: qualify(field.Type), variadic: variadic}
+		for _, n := range field.Names {
+			p.name = n.Name
+			m.params = append(m.params, p)
+		}
+	}
+	if fn.Results == nil || len(fn.Results.List) != 2 {
+		return m, fmt.Errorf("%s: methods must return pointer and error", name)
+	}
+	for _, field := range fn.Results.List {
+		m.results = append(m.results, qualify(field.Type))
+	}
+	if !strings.HasPrefix(m.results[0], "*") || m.results[1] != "error" {
+		return m, fmt.Errorf("%s: methods must return pointer and error", name)
+	}
+	return m, nil
+}
+
+func (m method) signature() string {
+	parts := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		parts = append(parts, p.name+" "+p.typ)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (m method) callArgs() string {
+	parts := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		if p.variadic {
+			parts = append(parts, p.name+"...")
+			continue
+		}
+		parts = append(parts, p.name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// fallbackArgs returns arguments passed to the WithContext variant of the method
+func (m method) fallbackArgs() string {
+	if args := m.callArgs(); len(args) > 0 {
+		return "context.Background(), " + args
+	}
+	return "context.Background()"
+}
+
+// recordArgs returns arguments recorded with the call, context is skipped as it's rarely asserted on
+func (m method) recordArgs() string {
+	var parts []string
+	for _, p := range m.params {
+		if p.typ == "context.Context" {
+			continue
+		}
+		parts = append(parts, p.name)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(parts, ", ")
+}
+
+// qualify returns type expression with identifiers exported by reamaze package prefixed with reamaze.
+func qualify(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return "reamaze." + e.Name
+		}
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + qualify(e.X)
+	case *ast.SelectorExpr:
+		return qualify(e.X.(*ast.Ident)) + "." + e.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + qualify(e.Elt)
+	case *ast.MapType:
+		return "map[" + qualify(e.Key) + "]" + qualify(e.Value)
+	case *ast.Ellipsis:
+		return "..." + qualify(e.Elt)
+	default:
+		log.Fatalf("unsupported type expression %T", expr)
+		return ""
+	}
+}
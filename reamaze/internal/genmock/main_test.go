@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGenerateUpToDate(t *testing.T) {
+	src, err := os.ReadFile("../../api.go")
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = %v", err)
+	}
+	got, err := generate(src)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	want, err := os.ReadFile("../../reamazemock/mock_gen.go")
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("reamazemock/mock_gen.go is out of date, run go generate in the reamaze package")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "Testing unnamed parameters", src: "package reamaze\ntype S interface{ Get(string) (*R, error) }"},
+		{name: "Testing single result", src: "package reamaze\ntype S interface{ Get(slug string) error }"},
+		{name: "Testing non pointer result", src: "package reamaze\ntype S interface{ Get(slug string) (R, error) }"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := generate([]byte(tt.src)); err == nil {
+				t.Errorf("generate() expected error")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -9,11 +10,52 @@ import (
 )
 
 // GetMessages call to messages will allow you to retrieve individual messages for all conversations in the Brand
+// optional parameters WithMessagesStartDate(int,int,int), WithMessagesEndDate(int,int,int), WithMessagesPage(int), WithMessagesFilter(string), WithMessagesVisibility(ReamazeVisibility)
 // https://www.reamaze.com/api/get_messages
-func (c *Client) GetMessages() (*GetMessagesResponse, error) {
+func (c *Client) GetMessages(o ...MessagesOption) (*GetMessagesResponse, error) {
+	return c.GetMessagesWithContext(context.Background(), o...)
+}
+
+// GetMessagesWithContext is like GetMessages but uses ctx for the underlying request.
+func (c *Client) GetMessagesWithContext(ctx context.Context, o ...MessagesOption) (*GetMessagesResponse, error) {
+	var response *GetMessagesResponse
+	settings, err := newMessagesSettings(o)
+	if err != nil {
+		return nil, err
+	}
+	urlEndpoint := messagesEndpoint + settings.GetQuery()
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetConversationMessages will allow you to retrieve messages of a specific conversation
+// optional parameters are the same as for GetMessages
+// https://www.reamaze.com/api/get_messages
+func (c *Client) GetConversationMessages(slug string, o ...MessagesOption) (*GetMessagesResponse, error) {
+	return c.GetConversationMessagesWithContext(context.Background(), slug, o...)
+}
+
+// GetConversationMessagesWithContext is like GetConversationMessages but uses ctx for the underlying request.
+func (c *Client) GetConversationMessagesWithContext(ctx context.Context, slug string, o ...MessagesOption) (*GetMessagesResponse, error) {
 	var response *GetMessagesResponse
-	urlEndpoint := messagesEndpoint
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	// checking if slug is set
+	if len(slug) == 0 {
+		return nil, errors.New("GetConversationMessages slug cannot be empty, please provide slug as argument")
+	}
+	settings, err := newMessagesSettings(o)
+	if err != nil {
+		return nil, err
+	}
+	urlEndpoint := conversationsEndpoint + "/" + url.PathEscape(slug) + "/messages" + settings.GetQuery()
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +69,11 @@ func (c *Client) GetMessages() (*GetMessagesResponse, error) {
 
 // CreateMessage will allow you to create a new message under a specific conversation https://www.reamaze.com/api/post_messages
 func (c *Client) CreateMessage(slug string, req *CreateMessageRequest) (*CreateMessageResponse, error) {
+	return c.CreateMessageWithContext(context.Background(), slug, req)
+}
+
+// CreateMessageWithContext is like CreateMessage but uses ctx for the underlying request.
+func (c *Client) CreateMessageWithContext(ctx context.Context, slug string, req *CreateMessageRequest) (*CreateMessageResponse, error) {
 	var response *CreateMessageResponse
 	emptyReq := &CreateMessageRequest{}
 	// checking if we don't have empty request
@@ -42,7 +89,7 @@ func (c *Client) CreateMessage(slug string, req *CreateMessageRequest) (*CreateM
 
 	data, _ := json.Marshal(req)
 
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
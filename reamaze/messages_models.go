@@ -1,99 +1,153 @@
 package reamaze
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
 
 const messagesEndpoint string = "/api/v1/messages"
 
 type ReamazeVisibility int
+type ReamazeMessagesStartDate time.Time
+type ReamazeMessagesEndDate time.Time
+type ReamazeMessagesPage int
+type ReamazeMessagesFilter string
+type ReamazeMessagesVisibility ReamazeVisibility
 
 const (
 	ReamazeVisibilityRegular      ReamazeVisibility = 0
 	ReamazeVisibilityInternalNote ReamazeVisibility = 1
 )
 
+type MessagesOption interface {
+	Apply(*ReamazeMessagesOptions)
+}
+
+func (w ReamazeMessagesStartDate) Apply(o *ReamazeMessagesOptions) {
+	o.setDate("start_date", time.Time(w))
+}
+
+func (w ReamazeMessagesEndDate) Apply(o *ReamazeMessagesOptions) {
+	o.setDate("end_date", time.Time(w))
+}
+
+func (w ReamazeMessagesPage) Apply(o *ReamazeMessagesOptions) {
+	o.setPage("WithMessagesPage", int(w))
+}
+
+func (w ReamazeMessagesFilter) Apply(o *ReamazeMessagesOptions) {
+	if len(w) > 0 {
+		o.set("filter", string(w))
+	}
+}
+
+// visibility is always applied as ReamazeVisibilityRegular is 0
+func (w ReamazeMessagesVisibility) Apply(o *ReamazeMessagesOptions) {
+	if ReamazeVisibility(w) != ReamazeVisibilityRegular && ReamazeVisibility(w) != ReamazeVisibilityInternalNote {
+		o.invalid(fmt.Errorf("WithMessagesVisibility visibility %d is unknown, please provide ReamazeVisibilityRegular or ReamazeVisibilityInternalNote", int(w)))
+		return
+	}
+	o.set("visibility", strconv.Itoa(int(w)))
+}
+
+func WithMessagesStartDate(year, month, day int) ReamazeMessagesStartDate {
+	startDate := ReamazeMessagesStartDate{}
+	if year > 0 && month > 0 && day > 0 {
+		startDate = ReamazeMessagesStartDate(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC))
+	}
+	return startDate
+}
+
+func WithMessagesEndDate(year, month, day int) ReamazeMessagesEndDate {
+	endDate := ReamazeMessagesEndDate{}
+	if year > 0 && month > 0 && day > 0 {
+		endDate = ReamazeMessagesEndDate(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC))
+	}
+	return endDate
+}
+
+func WithMessagesPage(page int) ReamazeMessagesPage {
+	return ReamazeMessagesPage(page)
+}
+
+func WithMessagesFilter(filter string) ReamazeMessagesFilter {
+	return ReamazeMessagesFilter(filter)
+}
+
+// WithMessagesVisibility returns only regular messages (ReamazeVisibilityRegular) or only internal notes (ReamazeVisibilityInternalNote)
+func WithMessagesVisibility(visibility ReamazeVisibility) ReamazeMessagesVisibility {
+	return ReamazeMessagesVisibility(visibility)
+}
+
+func newMessagesSettings(opts []MessagesOption) (*ReamazeMessagesOptions, error) {
+	var o ReamazeMessagesOptions
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	if err := o.Err(); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ReamazeMessagesOptions are the query parameters of GetMessages set by MessagesOption
+type ReamazeMessagesOptions struct {
+	ReamazeQuery
+}
+
 type GetMessagesResponse struct {
-	PageSize   int `json:"page_size"`
-	PageCount  int `json:"page_count"`
-	TotalCount int `json:"total_count"`
-	Messages   []struct {
-		Visibility   int       `json:"visibility"`
-		Origin       int       `json:"origin"`
-		CreatedAt    time.Time `json:"created_at"`
-		Conversation struct {
-			Subject   string    `json:"subject"`
-			Slug      string    `json:"slug"`
-			CreatedAt time.Time `json:"created_at"`
-			Category  struct {
-				ID      int    `json:"id"`
-				Name    string `json:"name"`
-				Slug    string `json:"slug"`
-				Email   string `json:"email"`
-				Channel int    `json:"channel"`
-			} `json:"category"`
-			Followers []any `json:"followers"`
-		} `json:"conversation"`
-		Attachments []struct {
-			ThumbURL        string `json:"thumb_url"`
-			URL             string `json:"url"`
-			Image           bool   `json:"image?"`
-			FileContentType string `json:"file_content_type"`
-			FileFileName    string `json:"file_file_name"`
-			FileFileSize    int    `json:"file_file_size"`
-		} `json:"attachments"`
-		Body             string `json:"body"`
-		DirectRecipients []any  `json:"direct_recipients"`
-		Recipients       []any  `json:"recipients"`
-		User             struct {
-			Name   string `json:"name"`
-			Email  string `json:"email"`
-			Mobile any    `json:"mobile"`
-			Staff  bool   `json:"staff?"`
-		} `json:"user,omitempty"`
-		Meta struct {
-			Subject  string `json:"Subject"`
-			Language struct {
-				Name     string `json:"name"`
-				Code     string `json:"code"`
-				Reliable bool   `json:"reliable"`
-			} `json:"language"`
-		} `json:"meta,omitempty"`
-	} `json:"messages"`
+	PageSize   int       `json:"page_size"`
+	PageCount  int       `json:"page_count"`
+	TotalCount int       `json:"total_count"`
+	Messages   []Message `json:"messages"`
 }
 
 type CreateMessageResponse struct {
-	Body       string `json:"body"`
-	Visibility int    `json:"visibility"`
-	CreatedAt  string `json:"created_at"`
-	OriginID   string `json:"origin_id"`
-	User       struct {
-		Name  string `json:"name"`
-		Email string `json:"email"`
-	} `json:"user"`
-	Conversation struct {
-		Subject   string `json:"subject"`
-		Slug      string `json:"slug"`
-		CreatedAt string `json:"created_at"`
-		Category  struct {
-			Name    string `json:"name"`
-			Slug    string `json:"slug"`
-			Email   string `json:"email"`
-			Channel int    `json:"channel"`
-		} `json:"category"`
-	} `json:"conversation"`
+	Body         string              `json:"body"`
+	Visibility   int                 `json:"visibility"`
+	CreatedAt    string              `json:"created_at"`
+	OriginID     string              `json:"origin_id"`
+	User         User                `json:"user"`
+	Conversation MessageConversation `json:"conversation"`
+}
+
+// MessageRequest is the message created by CreateMessage
+type MessageRequest struct {
+	Body                string            `json:"body"`
+	Visibility          ReamazeVisibility `json:"visibility,omitempty"`
+	OriginID            string            `json:"origin_id,omitempty"`
+	User                *User             `json:"user,omitempty"`
+	SupressNotification bool              `json:"suppress_notifications,omitempty"` // You can optionally pass in a message[suppress_notifications] boolean attribute with a value of true to prevent Reamaze from sending any email (or integration) notifications related to this message.
+	SupressAutoresolve  bool              `json:"suppress_autoresolve,omitempty"`
+	Attachment          string            `json:"attachment,omitempty"`
+	Attachments         []string          `json:"attachments,omitempty"`
 }
 
 type CreateMessageRequest struct {
-	Message struct {
-		Body       string            `json:"body"`
-		Visibility ReamazeVisibility `json:"visibility,omitempty"`
-		OriginID   string            `json:"origin_id,omitempty"`
-		User       *struct {
-			Name  string `json:"name,omitempty"`
-			Email string `json:"email,omitempty"`
-		} `json:"user,omitempty"`
-		SupressNotification bool     `json:"suppress_notifications,omitempty"` // You can optionally pass in a message[suppress_notifications] boolean attribute with a value of true to prevent Reamaze from sending any email (or integration) notifications related to this message.
-		SupressAutoresolve  bool     `json:"suppress_autoresolve,omitempty"`
-		Attachment          string   `json:"attachment,omitempty"`
-		Attachments         []string `json:"attachments,omitempty"`
-	} `json:"message"`
+	Message MessageRequest `json:"message"`
+}
+
+// Message is a single message returned in GetMessagesResponse
+type Message struct {
+	Visibility       int                 `json:"visibility"`
+	Origin           ReamazeOrigin       `json:"origin"`
+	CreatedAt        time.Time           `json:"created_at"`
+	Conversation     MessageConversation `json:"conversation"`
+	Attachments      []Attachment        `json:"attachments"`
+	Body             string              `json:"body"`
+	DirectRecipients []any               `json:"direct_recipients"`
+	Recipients       []any               `json:"recipients"`
+	User             User                `json:"user,omitempty"`
+	Meta             MessageMeta         `json:"meta,omitempty"`
+}
+
+// MessageMeta holds additional information about the message e.g. detected language
+type MessageMeta struct {
+	Subject  string `json:"Subject"`
+	Language struct {
+		Name     string `json:"name"`
+		Code     string `json:"code"`
+		Reliable bool   `json:"reliable"`
+	} `json:"language"`
 }
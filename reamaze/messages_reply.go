@@ -0,0 +1,163 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+type ReamazeReplyStaffUser string
+type ReamazeReplySuppressNotifications bool
+type ReamazeReplySuppressAutoresolve bool
+type ReamazeReplyOriginID string
+
+// ReamazeReplyAttachment is a file attached with WithReplyAttachment or WithReplyAttachmentFile
+type ReamazeReplyAttachment struct {
+	Path        string
+	Reader      io.Reader
+	Filename    string
+	ContentType string
+}
+
+// ReplyOption is an optional parameter of ReplyToConversation and AddInternalNote
+type ReplyOption interface {
+	Apply(*ReamazeReplyOptions)
+}
+
+type ReamazeReplyOptions struct {
+	StaffUser             string
+	SuppressNotifications bool
+	SuppressAutoresolve   bool
+	OriginID              string
+	Attachments           []ReamazeReplyAttachment
+}
+
+func (w ReamazeReplyStaffUser) Apply(o *ReamazeReplyOptions) {
+	if len(w) > 0 {
+		o.StaffUser = string(w)
+	}
+}
+
+func (w ReamazeReplySuppressNotifications) Apply(o *ReamazeReplyOptions) {
+	o.SuppressNotifications = bool(w)
+}
+
+func (w ReamazeReplySuppressAutoresolve) Apply(o *ReamazeReplyOptions) {
+	o.SuppressAutoresolve = bool(w)
+}
+
+func (w ReamazeReplyOriginID) Apply(o *ReamazeReplyOptions) {
+	if len(w) > 0 {
+		o.OriginID = string(w)
+	}
+}
+
+func (w ReamazeReplyAttachment) Apply(o *ReamazeReplyOptions) {
+	o.Attachments = append(o.Attachments, w)
+}
+
+// WithReplyStaffUser sends the message as the staff user with given email instead of the API user
+func WithReplyStaffUser(email string) ReamazeReplyStaffUser {
+	return ReamazeReplyStaffUser(email)
+}
+
+// WithReplySuppressNotifications prevents re:amaze from sending email (or integration) notifications about the message
+func WithReplySuppressNotifications() ReamazeReplySuppressNotifications {
+	return ReamazeReplySuppressNotifications(true)
+}
+
+// WithReplySuppressAutoresolve prevents re:amaze from resolving the conversation after the staff reply
+func WithReplySuppressAutoresolve() ReamazeReplySuppressAutoresolve {
+	return ReamazeReplySuppressAutoresolve(true)
+}
+
+// WithReplyOriginID sets the external id of the message, use the same id when retrying so that the message isn't posted twice
+func WithReplyOriginID(id string) ReamazeReplyOriginID {
+	return ReamazeReplyOriginID(id)
+}
+
+// WithReplyAttachment attaches content of r, see NewAttachment
+func WithReplyAttachment(r io.Reader, filename, contentType string) ReamazeReplyAttachment {
+	return ReamazeReplyAttachment{Reader: r, Filename: filename, ContentType: contentType}
+}
+
+// WithReplyAttachmentFile attaches file at path, see NewAttachmentFromFile
+func WithReplyAttachmentFile(path string) ReamazeReplyAttachment {
+	return ReamazeReplyAttachment{Path: path}
+}
+
+func newReplySettings(opts []ReplyOption) *ReamazeReplyOptions {
+	var o ReamazeReplyOptions
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	return &o
+}
+
+// ReplyToConversation posts a staff reply visible to the customer, optional parameters WithReplyStaffUser(string),
+// WithReplySuppressNotifications(), WithReplySuppressAutoresolve(), WithReplyOriginID(string), WithReplyAttachment(io.Reader, string, string)
+// and WithReplyAttachmentFile(string)
+func (c *Client) ReplyToConversation(slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error) {
+	return c.ReplyToConversationWithContext(context.Background(), slug, body, o...)
+}
+
+// ReplyToConversationWithContext is like ReplyToConversation but uses ctx for the underlying request.
+func (c *Client) ReplyToConversationWithContext(ctx context.Context, slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error) {
+	// checking if body is set
+	if len(body) == 0 {
+		return nil, errors.New("ReplyToConversation body cannot be empty, please provide body as argument")
+	}
+	req, err := newReplyRequest(body, ReamazeVisibilityRegular, o)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateMessageWithContext(ctx, slug, req)
+}
+
+// AddInternalNote posts an internal note to the conversation, visible to staff only. It takes the same optional parameters as ReplyToConversation,
+// notes don't change the conversation status so WithReplySuppressAutoresolve() has no effect.
+func (c *Client) AddInternalNote(slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error) {
+	return c.AddInternalNoteWithContext(context.Background(), slug, body, o...)
+}
+
+// AddInternalNoteWithContext is like AddInternalNote but uses ctx for the underlying request.
+func (c *Client) AddInternalNoteWithContext(ctx context.Context, slug string, body string, o ...ReplyOption) (*CreateMessageResponse, error) {
+	// checking if body is set
+	if len(body) == 0 {
+		return nil, errors.New("AddInternalNote body cannot be empty, please provide body as argument")
+	}
+	req, err := newReplyRequest(body, ReamazeVisibilityInternalNote, o)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateMessageWithContext(ctx, slug, req)
+}
+
+// newReplyRequest builds CreateMessageRequest from the options, attachments are read and encoded here
+func newReplyRequest(body string, visibility ReamazeVisibility, o []ReplyOption) (*CreateMessageRequest, error) {
+	settings := newReplySettings(o)
+	req := &CreateMessageRequest{}
+	req.Message.Body = body
+	req.Message.Visibility = visibility
+	req.Message.SupressNotification = settings.SuppressNotifications
+	req.Message.SupressAutoresolve = settings.SuppressAutoresolve
+	req.Message.OriginID = settings.OriginID
+	if len(settings.StaffUser) > 0 {
+		req.Message.User = &User{Email: settings.StaffUser}
+	}
+	for _, a := range settings.Attachments {
+		var err error
+		switch {
+		case len(a.Path) > 0:
+			err = req.Message.AttachFile(a.Path)
+		case a.Reader != nil:
+			err = req.Message.Attach(a.Reader, a.Filename, a.ContentType)
+		default:
+			err = errors.New("attachment without path or reader, please use WithReplyAttachment or WithReplyAttachmentFile")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
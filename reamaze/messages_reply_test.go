@@ -0,0 +1,118 @@
+package reamaze
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClient_ReplyToConversation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		note    bool
+		slug    string
+		body    string
+		opts    []ReplyOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Testing plain reply",
+			slug: "dummy",
+			body: "Thanks",
+			want: `{"message":{"body":"Thanks"}}`,
+		},
+		{
+			name: "Testing reply with all options",
+			slug: "dummy",
+			body: "Invoice attached",
+			opts: []ReplyOption{
+				WithReplyStaffUser("agent@example.com"),
+				WithReplySuppressNotifications(),
+				WithReplySuppressAutoresolve(),
+				WithReplyOriginID("ticket-1"),
+				WithReplyAttachmentFile(path),
+				WithReplyAttachment(strings.NewReader("hi"), "hi.txt", "text/plain"),
+			},
+			want: `{"message":{"body":"Invoice attached","origin_id":"ticket-1","user":{"email":"agent@example.com"},"suppress_notifications":true,"suppress_autoresolve":true,` +
+				`"attachments":["data:application/pdf;name=invoice.pdf;base64,JVBERi0=","data:text/plain;name=hi.txt;base64,aGk="]}}`,
+		},
+		{
+			name: "Testing internal note",
+			note: true,
+			slug: "dummy",
+			body: "Checking",
+			opts: []ReplyOption{WithReplyStaffUser("agent@example.com")},
+			want: `{"message":{"body":"Checking","visibility":1,"user":{"email":"agent@example.com"}}}`,
+		},
+		{
+			name:    "Testing empty body",
+			slug:    "dummy",
+			wantErr: true,
+		},
+		{
+			name:    "Testing empty note body",
+			note:    true,
+			slug:    "dummy",
+			wantErr: true,
+		},
+		{
+			name:    "Testing empty slug",
+			body:    "Thanks",
+			wantErr: true,
+		},
+		{
+			name:    "Testing missing attachment file",
+			slug:    "dummy",
+			body:    "Thanks",
+			opts:    []ReplyOption{WithReplyAttachmentFile(filepath.Join(t.TempDir(), "dummy"))},
+			wantErr: true,
+		},
+		{
+			name:    "Testing attachment without reader",
+			slug:    "dummy",
+			body:    "Thanks",
+			opts:    []ReplyOption{ReamazeReplyAttachment{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			c := &Client{
+				baseURL: "https://dummy.reamaze.io",
+				auth:    "dummy",
+				httpClient: &http.Client{
+					Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+						body, _ := io.ReadAll(req.Body)
+						got = string(body)
+						return &http.Response{
+							StatusCode: http.StatusCreated,
+							Status:     "201 Created",
+							Body:       io.NopCloser(strings.NewReader(`{"body":"dummy"}`)),
+						}
+					}),
+				},
+			}
+			var err error
+			if tt.note {
+				_, err = c.AddInternalNote(tt.slug, tt.body, tt.opts...)
+			} else {
+				_, err = c.ReplyToConversation(tt.slug, tt.body, tt.opts...)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("request body = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
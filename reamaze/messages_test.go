@@ -113,21 +113,7 @@ func TestClient_CreateMessage(t *testing.T) {
 					}
 				}),
 			}},
-			args: args{slug: "dummy", req: &CreateMessageRequest{Message: struct {
-				Body       string            "json:\"body\""
-				Visibility ReamazeVisibility "json:\"visibility,omitempty\""
-				OriginID   string            "json:\"origin_id,omitempty\""
-				User       *struct {
-					Name  string "json:\"name,omitempty\""
-					Email string "json:\"email,omitempty\""
-				} "json:\"user,omitempty\""
-				SupressNotification bool     "json:\"suppress_notifications,omitempty\""
-				SupressAutoresolve  bool     "json:\"suppress_autoresolve,omitempty\""
-				Attachment          string   "json:\"attachment,omitempty\""
-				Attachments         []string "json:\"attachments,omitempty\""
-			}{
-				Body: "dummy",
-			}}},
+			args:    args{slug: "dummy", req: &CreateMessageRequest{Message: MessageRequest{Body: "dummy"}}},
 			want:    &CreateMessageResponse{},
 			wantErr: false,
 		},
@@ -142,21 +128,7 @@ func TestClient_CreateMessage(t *testing.T) {
 					}
 				}),
 			}},
-			args: args{slug: "dummy", req: &CreateMessageRequest{Message: struct {
-				Body       string            "json:\"body\""
-				Visibility ReamazeVisibility "json:\"visibility,omitempty\""
-				OriginID   string            "json:\"origin_id,omitempty\""
-				User       *struct {
-					Name  string "json:\"name,omitempty\""
-					Email string "json:\"email,omitempty\""
-				} "json:\"user,omitempty\""
-				SupressNotification bool     "json:\"suppress_notifications,omitempty\""
-				SupressAutoresolve  bool     "json:\"suppress_autoresolve,omitempty\""
-				Attachment          string   "json:\"attachment,omitempty\""
-				Attachments         []string "json:\"attachments,omitempty\""
-			}{
-				Body: "dummy",
-			}}},
+			args:    args{slug: "dummy", req: &CreateMessageRequest{Message: MessageRequest{Body: "dummy"}}},
 			want:    nil,
 			wantErr: true,
 		},
@@ -171,21 +143,7 @@ func TestClient_CreateMessage(t *testing.T) {
 					}
 				}),
 			}},
-			args: args{slug: "dummy", req: &CreateMessageRequest{Message: struct {
-				Body       string            "json:\"body\""
-				Visibility ReamazeVisibility "json:\"visibility,omitempty\""
-				OriginID   string            "json:\"origin_id,omitempty\""
-				User       *struct {
-					Name  string "json:\"name,omitempty\""
-					Email string "json:\"email,omitempty\""
-				} "json:\"user,omitempty\""
-				SupressNotification bool     "json:\"suppress_notifications,omitempty\""
-				SupressAutoresolve  bool     "json:\"suppress_autoresolve,omitempty\""
-				Attachment          string   "json:\"attachment,omitempty\""
-				Attachments         []string "json:\"attachments,omitempty\""
-			}{
-				Body: "dummy",
-			}}},
+			args:    args{slug: "dummy", req: &CreateMessageRequest{Message: MessageRequest{Body: "dummy"}}},
 			want:    nil,
 			wantErr: true,
 		},
@@ -215,21 +173,7 @@ func TestClient_CreateMessage(t *testing.T) {
 					}
 				}),
 			}},
-			args: args{slug: "", req: &CreateMessageRequest{Message: struct {
-				Body       string            "json:\"body\""
-				Visibility ReamazeVisibility "json:\"visibility,omitempty\""
-				OriginID   string            "json:\"origin_id,omitempty\""
-				User       *struct {
-					Name  string "json:\"name,omitempty\""
-					Email string "json:\"email,omitempty\""
-				} "json:\"user,omitempty\""
-				SupressNotification bool     "json:\"suppress_notifications,omitempty\""
-				SupressAutoresolve  bool     "json:\"suppress_autoresolve,omitempty\""
-				Attachment          string   "json:\"attachment,omitempty\""
-				Attachments         []string "json:\"attachments,omitempty\""
-			}{
-				Body: "dummy",
-			}}},
+			args:    args{slug: "", req: &CreateMessageRequest{Message: MessageRequest{Body: "dummy"}}},
 			want:    nil,
 			wantErr: true,
 		},
@@ -252,3 +196,196 @@ func TestClient_CreateMessage(t *testing.T) {
 		})
 	}
 }
+
+func Test_newMessagesSettings(t *testing.T) {
+	type args struct {
+		opts []MessagesOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Testing newMessagesSettings expansion when no arguments provided",
+			args:    args{},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newMessagesSettings with all options",
+			args:    args{opts: []MessagesOption{WithMessagesStartDate(2024, 1, 1), WithMessagesEndDate(2024, 1, 31), WithMessagesPage(2), WithMessagesFilter("dummy"), WithMessagesVisibility(ReamazeVisibilityInternalNote)}},
+			want:    "?end_date=2024-01-31&filter=dummy&page=2&start_date=2024-01-01&visibility=1",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newMessagesSettings keeps regular visibility",
+			args:    args{opts: []MessagesOption{WithMessagesVisibility(ReamazeVisibilityRegular)}},
+			want:    "?visibility=0",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newMessagesSettings ignores empty values",
+			args:    args{opts: []MessagesOption{WithMessagesStartDate(0, 0, 0), WithMessagesEndDate(2024, 0, 1), WithMessagesPage(0), WithMessagesFilter("")}},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newMessagesSettings with unknown visibility",
+			args:    args{opts: []MessagesOption{WithMessagesVisibility(ReamazeVisibility(5))}},
+			wantErr: true,
+		},
+		{
+			name:    "Testing newMessagesSettings with negative page",
+			args:    args{opts: []MessagesOption{WithMessagesPage(-1)}},
+			wantErr: true,
+		},
+		{
+			name:    "Testing newMessagesSettings with start date after end date",
+			args:    args{opts: []MessagesOption{WithMessagesStartDate(2024, 2, 1), WithMessagesEndDate(2024, 1, 31)}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newMessagesSettings(tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newMessagesSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.GetQuery() != tt.want {
+				t.Errorf("newMessagesSettings() = %v, want %v", got.GetQuery(), tt.want)
+			}
+		})
+	}
+}
+
+func TestReamazeMessagesOptions_GetQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []MessagesOption
+		want string
+	}{
+		{
+			name: "Testing ReamazeMessagesOptions.GetQuery no options set",
+			want: "",
+		},
+		{
+			name: "Testing ReamazeMessagesOptions.GetQuery with options set",
+			opts: []MessagesOption{WithMessagesFilter("dummy"), WithMessagesVisibility(ReamazeVisibilityInternalNote), WithMessagesStartDate(2024, 1, 1), WithMessagesEndDate(2024, 1, 31), WithMessagesPage(2)},
+			want: "?end_date=2024-01-31&filter=dummy&page=2&start_date=2024-01-01&visibility=1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r ReamazeMessagesOptions
+			for _, opt := range tt.opts {
+				opt.Apply(&r)
+			}
+			if got := r.GetQuery(); got != tt.want {
+				t.Errorf("ReamazeMessagesOptions.GetQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetConversationMessages(t *testing.T) {
+	type fields struct {
+		baseURL    string
+		auth       string
+		httpClient *http.Client
+	}
+	type args struct {
+		slug string
+		o    []MessagesOption
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *GetMessagesResponse
+		wantErr bool
+	}{
+		{
+			name: "Testing empty slug",
+			fields: fields{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Status:     "200 Status OK",
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+					}
+				}),
+			}},
+			args:    args{slug: ""},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Testing bad response code",
+			fields: fields{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Status:     "404 Not Found",
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+					}
+				}),
+			}},
+			args:    args{slug: "dummy"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Testing bad response json",
+			fields: fields{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Status:     "200 Status OK",
+						Body:       io.NopCloser(strings.NewReader(`{`)),
+					}
+				}),
+			}},
+			args:    args{slug: "dummy"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Testing correct response json",
+			fields: fields{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					if req.URL.Path != "/api/v1/conversations/dummy/messages" || req.URL.RawQuery != "page=2&visibility=1" {
+						return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader(`{}`))}
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Status:     "200 Status OK",
+						Body:       io.NopCloser(strings.NewReader(`{"page_count":2}`)),
+					}
+				}),
+			}},
+			args:    args{slug: "dummy", o: []MessagesOption{WithMessagesPage(2), WithMessagesVisibility(ReamazeVisibilityInternalNote)}},
+			want:    &GetMessagesResponse{PageCount: 2},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				baseURL:    tt.fields.baseURL,
+				auth:       tt.fields.auth,
+				httpClient: tt.fields.httpClient,
+			}
+			got, err := c.GetConversationMessages(tt.args.slug, tt.args.o...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.GetConversationMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.GetConversationMessages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
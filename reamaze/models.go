@@ -0,0 +1,65 @@
+package reamaze
+
+import "time"
+
+// Ptr returns pointer to v, it's handy for setting optional fields of requests e.g. UpdateConversationRequest
+//
+//	req.Conversation.Status = reamaze.Ptr(reamaze.ReamazeStatusUnresolved)
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// User is a re:amaze user, either a customer or a staff member, e.g. conversation author, follower or message sender
+type User struct {
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Data         any    `json:"data,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Twitter      string `json:"twitter,omitempty"`
+	Facebook     string `json:"facebook,omitempty"`
+	Instagram    string `json:"instagram,omitempty"`
+	Mobile       string `json:"mobile,omitempty"`
+	FriendlyName string `json:"friendly_name,omitempty"`
+	DisplayName  string `json:"display_name,omitempty"`
+	Staff        bool   `json:"staff?,omitempty"`
+	Customer     bool   `json:"customer?,omitempty"`
+	Bot          bool   `json:"bot?,omitempty"`
+}
+
+// Follower is a User following the conversation
+type Follower = User
+
+// Category is the channel a conversation belongs to
+type Category struct {
+	ID                       int    `json:"id,omitempty"`
+	Name                     string `json:"name,omitempty"`
+	Slug                     string `json:"slug,omitempty"`
+	Email                    string `json:"email,omitempty"`
+	Channel                  int    `json:"channel,omitempty"`
+	SettingsDisplayHTMLEmail string `json:"settings_display_html_email,omitempty"`
+}
+
+// Attachment is a file attached to a message
+type Attachment struct {
+	ThumbURL        string `json:"thumb_url,omitempty"`
+	URL             string `json:"url,omitempty"`
+	Image           bool   `json:"image?,omitempty"`
+	FileContentType string `json:"file_content_type,omitempty"`
+	FileFileName    string `json:"file_file_name,omitempty"`
+	FileFileSize    int    `json:"file_file_size,omitempty"`
+}
+
+// MessageSummary is the short form of a message embedded in conversations e.g. last_customer_message
+type MessageSummary struct {
+	Body      string    `json:"body,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// MessageConversation is the short form of a conversation embedded in messages
+type MessageConversation struct {
+	Subject   string     `json:"subject,omitempty"`
+	Slug      string     `json:"slug,omitempty"`
+	CreatedAt time.Time  `json:"created_at,omitempty"`
+	Category  Category   `json:"category,omitempty"`
+	Followers []Follower `json:"followers,omitempty"`
+}
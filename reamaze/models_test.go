@@ -0,0 +1,92 @@
+package reamaze
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetConversationResponse_SharedModels(t *testing.T) {
+	body := `{
+		"slug":"dummy",
+		"author":{"id":1,"name":"Customer","email":"customer@example.com"},
+		"category":{"name":"Support","slug":"support","email":"support@example.com","channel":1},
+		"followers":[{"id":2,"name":"Staff","email":"staff@example.com","staff?":true}],
+		"last_customer_message":{"body":"hello","created_at":"2024-01-01T10:00:00Z"}
+	}`
+	var got GetConversationResponse
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	want := GetConversationResponse{
+		Slug:                "dummy",
+		Author:              User{ID: 1, Name: "Customer", Email: "customer@example.com"},
+		Category:            Category{Name: "Support", Slug: "support", Email: "support@example.com", Channel: 1},
+		Followers:           []Follower{{ID: 2, Name: "Staff", Email: "staff@example.com", Staff: true}},
+		LastCustomerMessage: MessageSummary{Body: "hello", CreatedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetConversationResponse = %+v, want %+v", got, want)
+	}
+}
+
+func TestMessage_SharedModels(t *testing.T) {
+	body := `{
+		"body":"hello",
+		"user":{"name":"Customer","email":"customer@example.com","mobile":null,"staff?":false},
+		"attachments":[{"url":"https://example.com/file.pdf","file_file_name":"file.pdf","file_file_size":10}],
+		"conversation":{"slug":"dummy","category":{"id":1,"name":"Support"},"followers":[]}
+	}`
+	var got Message
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.User.Email != "customer@example.com" || got.Attachments[0] != (Attachment{URL: "https://example.com/file.pdf", FileFileName: "file.pdf", FileFileSize: 10}) || got.Conversation.Category.ID != 1 {
+		t.Errorf("Message = %+v", got)
+	}
+}
+
+func TestCreateConversationRequest_SharedModels(t *testing.T) {
+	req := CreateConversationRequest{Conversation: ConversationRequest{
+		Category: "support",
+		Message:  ConversationMessage{Body: "hello"},
+		User:     User{Name: "Customer", Email: "customer@example.com"},
+	}}
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"conversation":{"category":"support","message":{"body":"hello"},"user":{"name":"Customer","email":"customer@example.com"}}}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestUpdateConversationRequest_SharedModels(t *testing.T) {
+	req := UpdateConversationRequest{Conversation: ConversationUpdateRequest{Status: Ptr(ReamazeStatusResolved), TagList: Ptr([]string{})}}
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"conversation":{"tag_list":[],"status":2}}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestContact_SharedModels(t *testing.T) {
+	body := `{"name":"Customer","email":"customer@example.com","id":"customer-1","_id":42}`
+	var single GetContactResponse
+	if err := json.Unmarshal([]byte(body), &single); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	var list GetContactsResponse
+	if err := json.Unmarshal([]byte(`{"contacts":[`+body+`]}`), &list); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	want := Contact{Name: "Customer", Email: "customer@example.com", ID: "customer-1", ID0: 42}
+	if !reflect.DeepEqual(single, want) || len(list.Contacts) != 1 || !reflect.DeepEqual(list.Contacts[0], want) {
+		t.Errorf("GetContactResponse = %+v, GetContactsResponse = %+v, want %+v", single, list, want)
+	}
+}
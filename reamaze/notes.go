@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -11,13 +12,18 @@ import (
 
 // GetNotes gets all the Notes for provided identifier https://www.reamaze.com/api/get_notes
 func (c *Client) GetNotes(identifier string) (*GetNotesResponse, error) {
+	return c.GetNotesWithContext(context.Background(), identifier)
+}
+
+// GetNotesWithContext is like GetNotes but uses ctx for the underlying request.
+func (c *Client) GetNotesWithContext(ctx context.Context, identifier string) (*GetNotesResponse, error) {
 	var response *GetNotesResponse
 	// checking if identifier is set
 	if len(identifier) == 0 {
 		return nil, errors.New("GetNotes identifier cannot be empty, please provide identifier as argument")
 	}
 	urlEndpoint := contactsEndpoint + "/" + url.PathEscape(identifier) + "/notes"
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +40,11 @@ func (c *Client) GetNotes(identifier string) (*GetNotesResponse, error) {
 // creator_email is optional and should be the staff email address for the Re:amaze staff user who you want to be attributed to creating the note. Otherwise, the creator will be the user making the request.
 // created_at is optional and will default to the current time.
 func (c *Client) CreateNote(identifier string, req *CreateNoteRequest) (*CreateNoteResponse, error) {
+	return c.CreateNoteWithContext(context.Background(), identifier, req)
+}
+
+// CreateNoteWithContext is like CreateNote but uses ctx for the underlying request.
+func (c *Client) CreateNoteWithContext(ctx context.Context, identifier string, req *CreateNoteRequest) (*CreateNoteResponse, error) {
 	var response *CreateNoteResponse
 	emptyReq := &CreateNoteRequest{}
 	// checking if we don't have empty request
@@ -49,7 +60,7 @@ func (c *Client) CreateNote(identifier string, req *CreateNoteRequest) (*CreateN
 		req.CreatedAt = time.Now()
 	}
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +73,11 @@ func (c *Client) CreateNote(identifier string, req *CreateNoteRequest) (*CreateN
 
 // UpdateNote will allow you to update a note with the given id https://www.reamaze.com/api/put_note
 func (c *Client) UpdateNote(identifier string, noteID string, req *UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	return c.UpdateNoteWithContext(context.Background(), identifier, noteID, req)
+}
+
+// UpdateNoteWithContext is like UpdateNote but uses ctx for the underlying request.
+func (c *Client) UpdateNoteWithContext(ctx context.Context, identifier string, noteID string, req *UpdateNoteRequest) (*UpdateNoteResponse, error) {
 	var response *UpdateNoteResponse
 	emptyReq := &UpdateNoteRequest{}
 	// checking if we don't have empty request
@@ -80,7 +96,7 @@ func (c *Client) UpdateNote(identifier string, noteID string, req *UpdateNoteReq
 		req.CreatedAt = time.Now()
 	}
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPut, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPut, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +109,11 @@ func (c *Client) UpdateNote(identifier string, noteID string, req *UpdateNoteReq
 
 // DeleteNote will delete a note with the given id from the contact https://www.reamaze.com/api/delete_note
 func (c *Client) DeleteNote(identifier string, noteID string) (*DeleteNoteResponse, error) {
+	return c.DeleteNoteWithContext(context.Background(), identifier, noteID)
+}
+
+// DeleteNoteWithContext is like DeleteNote but uses ctx for the underlying request.
+func (c *Client) DeleteNoteWithContext(ctx context.Context, identifier string, noteID string) (*DeleteNoteResponse, error) {
 	var response *DeleteNoteResponse
 
 	if len(identifier) == 0 {
@@ -102,7 +123,7 @@ func (c *Client) DeleteNote(identifier string, noteID string) (*DeleteNoteRespon
 		return nil, errors.New("DeleteNote noteID cannot be empty, please provide noteID as argument")
 	}
 	urlEndpoint := contactsEndpoint + "/" + url.PathEscape(identifier) + "/notes/" + url.PathEscape(noteID)
-	resp, err := c.reamazeRequest(http.MethodDelete, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodDelete, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
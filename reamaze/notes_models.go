@@ -7,10 +7,7 @@ type Note struct {
 	Note      string    `json:"note,omitempty"`
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	Creator   struct {
-		Email string `json:"email,omitempty"`
-		Name  string `json:"name,omitempty"`
-	} `json:"creator,omitempty"`
+	Creator   User      `json:"creator,omitempty"`
 }
 
 type GetNotesResponse []Note
@@ -0,0 +1,155 @@
+package reamaze
+
+import (
+	"context"
+)
+
+// PageIterator lazily walks through all pages of a paginated re:amaze endpoint.
+// Next page is requested only when all items of the current page have been consumed.
+//
+//	it := client.ConversationsIter(ctx, reamaze.WithFilter(reamaze.ReamazeFilterOpen))
+//	for it.Next() {
+//		conversation := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type PageIterator[T any] struct {
+	ctx       context.Context
+	fetch     func(ctx context.Context, page int) ([]T, int, error)
+	page      int
+	pageCount int
+	items     []T
+	index     int
+	current   T
+	err       error
+	done      bool
+}
+
+func newPageIterator[T any](ctx context.Context, fetch func(ctx context.Context, page int) ([]T, int, error)) *PageIterator[T] {
+	return &PageIterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// Next advances the iterator to the next item, fetching the next page when needed.
+// It returns false when there are no more items or an error occurred, check Err() afterwards.
+func (it *PageIterator[T]) Next() bool {
+	for it.index >= len(it.items) {
+		if it.done {
+			return false
+		}
+		// checking if we already reached the last page
+		if it.page > 0 && it.pageCount > 0 && it.page >= it.pageCount {
+			it.done = true
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+		items, pageCount, err := it.fetch(it.ctx, it.page+1)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+		it.page++
+		it.pageCount = pageCount
+		it.items = items
+		it.index = 0
+		// empty page means there is nothing more to fetch
+		if len(items) == 0 {
+			it.done = true
+			return false
+		}
+	}
+	it.current = it.items[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the current item, it's valid only after Next returned true
+func (it *PageIterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+// Page returns the number of the last fetched page
+func (it *PageIterator[T]) Page() int {
+	return it.page
+}
+
+// ConversationsIter returns iterator over all conversations matching the options, WithPage option is ignored
+func (c *Client) ConversationsIter(ctx context.Context, o ...ConversationsOption) *PageIterator[GetConversationResponse] {
+	return newPageIterator(ctx, func(ctx context.Context, page int) ([]GetConversationResponse, int, error) {
+		opts := append(append([]ConversationsOption{}, o...), WithPage(page))
+		resp, err := c.GetConversationsWithContext(ctx, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Conversations, resp.PageCount, nil
+	})
+}
+
+// ContactsIter returns iterator over all contacts of the Account matching the options, WithContactsPage option is ignored
+func (c *Client) ContactsIter(ctx context.Context, o ...ContactsOption) *PageIterator[Contact] {
+	return newPageIterator(ctx, func(ctx context.Context, page int) ([]Contact, int, error) {
+		opts := append(append([]ContactsOption{}, o...), WithContactsPage(page))
+		resp, err := c.GetContactsWithContext(ctx, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Contacts, resp.PageCount, nil
+	})
+}
+
+// MessagesIter returns iterator over all messages of the Brand matching the options, WithMessagesPage option is ignored
+func (c *Client) MessagesIter(ctx context.Context, o ...MessagesOption) *PageIterator[Message] {
+	return newPageIterator(ctx, func(ctx context.Context, page int) ([]Message, int, error) {
+		opts := append(append([]MessagesOption{}, o...), WithMessagesPage(page))
+		resp, err := c.GetMessagesWithContext(ctx, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Messages, resp.PageCount, nil
+	})
+}
+
+// ConversationMessagesIter returns iterator over the whole thread of the conversation, WithMessagesPage option is ignored
+func (c *Client) ConversationMessagesIter(ctx context.Context, slug string, o ...MessagesOption) *PageIterator[Message] {
+	return newPageIterator(ctx, func(ctx context.Context, page int) ([]Message, int, error) {
+		opts := append(append([]MessagesOption{}, o...), WithMessagesPage(page))
+		resp, err := c.GetConversationMessagesWithContext(ctx, slug, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Messages, resp.PageCount, nil
+	})
+}
+
+// ArticlesIter returns iterator over all articles matching the options, WithArticlePage option is ignored
+func (c *Client) ArticlesIter(ctx context.Context, o ...ArticlesOption) *PageIterator[ReamazeArticle] {
+	return newPageIterator(ctx, func(ctx context.Context, page int) ([]ReamazeArticle, int, error) {
+		opts := append(append([]ArticlesOption{}, o...), WithArticlePage(page))
+		resp, err := c.GetArticlesWithContext(ctx, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Articles, resp.PageCount, nil
+	})
+}
+
+// StaffIter returns iterator over all staff users of the Account
+func (c *Client) StaffIter(ctx context.Context) *PageIterator[StaffUser] {
+	return newPageIterator(ctx, func(ctx context.Context, page int) ([]StaffUser, int, error) {
+		resp, err := c.GetStaffWithContext(ctx, WithStaffPage(page))
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Staff, resp.PageCount, nil
+	})
+}
@@ -0,0 +1,59 @@
+//go:build go1.23
+
+package reamaze
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns the remaining items of the iterator as iter.Seq2, the iteration stops after the first error
+//
+//	for conversation, err := range client.ConversationsIter(ctx).All() {
+//		if err != nil {
+//			// handle error
+//		}
+//	}
+func (it *PageIterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Value(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// AllConversations yields all conversations matching the options
+func (c *Client) AllConversations(ctx context.Context, o ...ConversationsOption) iter.Seq2[GetConversationResponse, error] {
+	return c.ConversationsIter(ctx, o...).All()
+}
+
+// AllContacts yields all contacts of the Account matching the options
+func (c *Client) AllContacts(ctx context.Context, o ...ContactsOption) iter.Seq2[Contact, error] {
+	return c.ContactsIter(ctx, o...).All()
+}
+
+// AllMessages yields all messages of the Brand matching the options
+func (c *Client) AllMessages(ctx context.Context, o ...MessagesOption) iter.Seq2[Message, error] {
+	return c.MessagesIter(ctx, o...).All()
+}
+
+// AllConversationMessages yields all messages of the conversation
+func (c *Client) AllConversationMessages(ctx context.Context, slug string, o ...MessagesOption) iter.Seq2[Message, error] {
+	return c.ConversationMessagesIter(ctx, slug, o...).All()
+}
+
+// AllArticles yields all articles matching the options
+func (c *Client) AllArticles(ctx context.Context, o ...ArticlesOption) iter.Seq2[ReamazeArticle, error] {
+	return c.ArticlesIter(ctx, o...).All()
+}
+
+// AllStaff yields all staff users of the Account
+func (c *Client) AllStaff(ctx context.Context) iter.Seq2[StaffUser, error] {
+	return c.StaffIter(ctx).All()
+}
@@ -0,0 +1,50 @@
+//go:build go1.23
+
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestClient_AllConversations(t *testing.T) {
+	c, _ := pagedClient(t, conversationsEndpoint, []string{
+		`{"page_count":3,"conversations":[{"slug":"a"}]}`,
+		`{"page_count":3,"conversations":[{"slug":"b"}]}`,
+	})
+	var got []string
+	var gotErr error
+	for conversation, err := range c.AllConversations(context.Background()) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, conversation.Slug)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("AllConversations() = %v", got)
+	}
+	if !errors.Is(gotErr, ErrNotFound) {
+		t.Errorf("AllConversations() error = %v, want %v", gotErr, ErrNotFound)
+	}
+}
+
+func TestClient_AllContacts(t *testing.T) {
+	c, requested := pagedClient(t, contactsEndpoint, []string{
+		`{"page_count":2,"contacts":[{"email":"a@example.com"},{"email":"b@example.com"}]}`,
+		`{"page_count":2,"contacts":[{"email":"c@example.com"}]}`,
+	})
+	var got []string
+	for contact, err := range c.AllContacts(context.Background()) {
+		if err != nil {
+			t.Fatalf("AllContacts() error = %v", err)
+		}
+		got = append(got, contact.Email)
+		break
+	}
+	if !reflect.DeepEqual(got, []string{"a@example.com"}) || len(*requested) != 1 {
+		t.Errorf("AllContacts() = %v, requested pages %v", got, *requested)
+	}
+}
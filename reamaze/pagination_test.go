@@ -0,0 +1,146 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// pagedClient returns Client serving given pages of JSON, the page is taken from the page query parameter
+func pagedClient(t *testing.T, path string, pages []string) (*Client, *[]string) {
+	var requested []string
+	return &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			if req.URL.Path != path {
+				t.Errorf("unexpected request path %v, want %v", req.URL.Path, path)
+			}
+			page := req.URL.Query().Get("page")
+			requested = append(requested, page)
+			var index int
+			fmt.Sscan(page, &index)
+			if index < 1 || index > len(pages) {
+				return &http.Response{StatusCode: http.StatusNotFound, Status: "Not Found", Body: io.NopCloser(strings.NewReader(`{"error":"Not Found"}`))}
+			}
+			return &http.Response{StatusCode: http.StatusOK, Status: "Status OK", Body: io.NopCloser(strings.NewReader(pages[index-1]))}
+		}),
+	}}, &requested
+}
+
+func TestClient_ConversationsIter(t *testing.T) {
+	c, requested := pagedClient(t, conversationsEndpoint, []string{
+		`{"page_count":2,"conversations":[{"slug":"a"},{"slug":"b"}]}`,
+		`{"page_count":2,"conversations":[{"slug":"c"}]}`,
+	})
+	var got []string
+	it := c.ConversationsIter(context.Background(), WithFilter(ReamazeFilterOpen), WithPage(5))
+	for it.Next() {
+		got = append(got, it.Value().Slug)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("PageIterator.Err() = %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("ConversationsIter() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+	if !reflect.DeepEqual(*requested, []string{"1", "2"}) {
+		t.Errorf("ConversationsIter() requested pages = %v, want %v", *requested, []string{"1", "2"})
+	}
+}
+
+func TestClient_ContactsIter(t *testing.T) {
+	c, _ := pagedClient(t, contactsEndpoint, []string{
+		`{"page_count":3,"contacts":[{"email":"a@example.com"}]}`,
+		`{"page_count":3,"contacts":[{"email":"b@example.com"}]}`,
+		`{"page_count":3,"contacts":[]}`,
+	})
+	var got []string
+	it := c.ContactsIter(context.Background())
+	for it.Next() {
+		got = append(got, it.Value().Email)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("PageIterator.Err() = %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"a@example.com", "b@example.com"}) {
+		t.Errorf("ContactsIter() = %v", got)
+	}
+}
+
+func TestClient_MessagesIter(t *testing.T) {
+	c, _ := pagedClient(t, messagesEndpoint, []string{
+		`{"page_count":2,"messages":[{"body":"first"}]}`,
+	})
+	var got []string
+	it := c.MessagesIter(context.Background())
+	for it.Next() {
+		got = append(got, it.Value().Body)
+	}
+	if !errors.Is(it.Err(), ErrNotFound) {
+		t.Errorf("PageIterator.Err() = %v, want %v", it.Err(), ErrNotFound)
+	}
+	if !reflect.DeepEqual(got, []string{"first"}) {
+		t.Errorf("MessagesIter() = %v", got)
+	}
+	if it.Next() {
+		t.Errorf("PageIterator.Next() = true after error")
+	}
+}
+
+func TestClient_ArticlesIter(t *testing.T) {
+	c, _ := pagedClient(t, articlesEndpoint, []string{
+		`{"page_count":1,"articles":[{"slug":"a"},{"slug":"b"}]}`,
+	})
+	var got []string
+	it := c.ArticlesIter(context.Background())
+	for it.Next() {
+		got = append(got, it.Value().Slug)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("PageIterator.Err() = %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) || it.Page() != 1 {
+		t.Errorf("ArticlesIter() = %v, page %v", got, it.Page())
+	}
+}
+
+func TestClient_StaffIter(t *testing.T) {
+	c, _ := pagedClient(t, staffEndpoint, []string{
+		`{"page_count":2,"staff":[{"email":"a@example.com"}]}`,
+		`{"page_count":2,"staff":[{"email":"b@example.com"}]}`,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	it := c.StaffIter(ctx)
+	if !it.Next() || it.Value().Email != "a@example.com" {
+		t.Fatalf("StaffIter() first item = %v", it.Value().Email)
+	}
+	cancel()
+	if it.Next() {
+		t.Errorf("PageIterator.Next() = true after context cancel")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("PageIterator.Err() = %v, want %v", it.Err(), context.Canceled)
+	}
+}
+
+func TestClient_ConversationMessagesIter(t *testing.T) {
+	c, requested := pagedClient(t, conversationsEndpoint+"/dummy/messages", []string{
+		`{"page_count":2,"messages":[{"body":"first"}]}`,
+		`{"page_count":2,"messages":[{"body":"second"}]}`,
+	})
+	var got []string
+	it := c.ConversationMessagesIter(context.Background(), "dummy")
+	for it.Next() {
+		got = append(got, it.Value().Body)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("PageIterator.Err() = %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"first", "second"}) || len(*requested) != 2 {
+		t.Errorf("ConversationMessagesIter() = %v, requested pages %v", got, *requested)
+	}
+}
@@ -0,0 +1,87 @@
+package reamaze
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// reamazeDateLayout is the format of start_date and end_date query parameters
+const reamazeDateLayout = "2006-01-02"
+
+// ReamazeQuery builds the query string of a request from its options, it's embedded in the options of every resource.
+// Parameters are encoded sorted by name, so the same options always give the same query. Options with invalid
+// values are recorded and returned by Err instead of being dropped.
+type ReamazeQuery struct {
+	values url.Values
+	errs   []error
+}
+
+// Values returns a copy of the query parameters
+func (q ReamazeQuery) Values() url.Values {
+	values := url.Values{}
+	for name, v := range q.values {
+		values[name] = append([]string{}, v...)
+	}
+	return values
+}
+
+// Get returns the value of query parameter name, empty string when it isn't set
+func (q ReamazeQuery) Get(name string) string {
+	return q.values.Get(name)
+}
+
+// Err returns errors of the invalid options joined together, nil when all of them are valid
+func (q ReamazeQuery) Err() error {
+	errs := q.errs
+	// checking if the date range isn't reversed, dates in reamazeDateLayout sort as strings
+	start, end := q.Get("start_date"), q.Get("end_date")
+	if len(start) > 0 && len(end) > 0 && start > end {
+		errs = append(errs, fmt.Errorf("start date %s is after end date %s, please provide start date before end date", start, end))
+	}
+	return errors.Join(errs...)
+}
+
+// GetQuery returns the encoded query prefixed with "?", or empty string when no parameter is set
+func (q ReamazeQuery) GetQuery() string {
+	if len(q.values) == 0 {
+		return ""
+	}
+	return "?" + q.values.Encode()
+}
+
+// set sets query parameter name to value replacing its previous value
+func (q *ReamazeQuery) set(name, value string) {
+	if q.values == nil {
+		q.values = url.Values{}
+	}
+	q.values.Set(name, value)
+}
+
+// setDate sets query parameter name to the date of t, zero time leaves the parameter unset
+func (q *ReamazeQuery) setDate(name string, t time.Time) {
+	if !t.IsZero() {
+		q.set(name, t.Format(reamazeDateLayout))
+	}
+}
+
+// setPage sets page parameter, zero page leaves it unset
+func (q *ReamazeQuery) setPage(option string, page int) {
+	switch {
+	case page < 0:
+		q.invalid(fmt.Errorf("%s page %d cannot be negative, please provide page number starting from 1", option, page))
+	case page > 0:
+		q.set("page", fmt.Sprint(page))
+	}
+}
+
+// invalid records error of an invalid option
+func (q *ReamazeQuery) invalid(err error) {
+	q.errs = append(q.errs, err)
+}
+
+// clone returns copy of q which can be changed without affecting q
+func (q ReamazeQuery) clone() ReamazeQuery {
+	return ReamazeQuery{values: q.Values(), errs: append([]error{}, q.errs...)}
+}
@@ -0,0 +1,76 @@
+package reamaze
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestReamazeQuery(t *testing.T) {
+	var o ReamazeOptions
+	WithData(map[string]string{"c": "3", "a": "1", "b": "2"}).Apply(&o)
+	WithFilter(ReamazeFilterOpen).Apply(&o)
+	want := "?data%5Ba%5D=1&data%5Bb%5D=2&data%5Bc%5D=3&filter=open"
+	// map iteration order is random, the query has to be the same every time
+	for i := 0; i < 20; i++ {
+		if got := o.GetQuery(); got != want {
+			t.Fatalf("GetQuery() = %v, want %v", got, want)
+		}
+	}
+
+	values := o.Values()
+	values.Set("filter", "dummy")
+	if got := o.Get("filter"); got != "open" {
+		t.Errorf("Get() after changing Values() = %v, want open", got)
+	}
+	clone := o.clone()
+	clone.set("filter", "dummy")
+	if got := o.Get("filter"); got != "open" {
+		t.Errorf("Get() after changing clone = %v, want open", got)
+	}
+	if err := o.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+
+	WithFor("dummy").Apply(&o)
+	WithPage(-2).Apply(&o)
+	err := o.Err()
+	if err == nil || !strings.Contains(err.Error(), `WithFor email "dummy" is invalid`) || !strings.Contains(err.Error(), "WithPage page -2 cannot be negative") {
+		t.Errorf("Err() = %v, want errors of WithFor and WithPage", err)
+	}
+}
+
+func TestClient_InvalidOptions(t *testing.T) {
+	c := &Client{
+		baseURL: "https://dummy.reamaze.io",
+		auth:    "dummy",
+		httpClient: &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				t.Errorf("unexpected request %s", req.URL)
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}
+			}),
+		},
+	}
+	calls := []struct {
+		name string
+		call func() error
+	}{
+		{"GetConversations", func() error { _, err := c.GetConversations(WithFor("dummy")); return err }},
+		{"GetMessages", func() error { _, err := c.GetMessages(WithMessagesPage(-1)); return err }},
+		{"GetArticles", func() error { _, err := c.GetArticles(WithArticleStatus(ReamazeArticleStatus(-1))); return err }},
+		{"GetContacts", func() error { _, err := c.GetContacts(WithContactsPage(-1)); return err }},
+		{"GetStaff", func() error { _, err := c.GetStaff(WithStaffPage(-1)); return err }},
+		{"GetReportsVolume", func() error {
+			_, err := c.GetReportsVolume(WithReportsStartDate(2024, 2, 1), WithReportsEndDate(2024, 1, 1))
+			return err
+		}},
+	}
+	for _, tt := range calls {
+		t.Run("Testing "+tt.name+" with invalid option", func(t *testing.T) {
+			if err := tt.call(); err == nil {
+				t.Errorf("%s() error = nil, want error", tt.name)
+			}
+		})
+	}
+}
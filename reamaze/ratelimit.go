@@ -0,0 +1,92 @@
+package reamaze
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type ReamazeRateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+func (w ReamazeRateLimit) Apply(o *ReamazeClientOptions) {
+	if w.RequestsPerSecond > 0 {
+		burst := w.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		o.RateLimit = &ReamazeRateLimit{RequestsPerSecond: w.RequestsPerSecond, Burst: burst}
+	}
+}
+
+// WithRateLimit makes every request of the Client wait for a token from a bucket refilled with requestsPerSecond tokens and holding up to burst tokens.
+// The bucket is shared by all goroutines using the same Client.
+func WithRateLimit(requestsPerSecond float64, burst int) ReamazeRateLimit {
+	return ReamazeRateLimit{RequestsPerSecond: requestsPerSecond, Burst: burst}
+}
+
+// rateLimiter is a token bucket safe for concurrent use
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64 // tokens currently available, negative when reserved by waiting callers
+	last   time.Time
+	now    func() time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes one token from the bucket and returns how long the caller has to wait before using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back the token taken by reserve when the caller didn't use it
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Wait blocks until a token is available or ctx is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	delay := l.reserve()
+	if delay == 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
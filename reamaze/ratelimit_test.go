@@ -0,0 +1,107 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		rps   float64
+		burst int
+		want  *ReamazeClientOptions
+	}{
+		{name: "Testing rate limit", rps: 5, burst: 10, want: &ReamazeClientOptions{RateLimit: &ReamazeRateLimit{RequestsPerSecond: 5, Burst: 10}}},
+		{name: "Testing burst defaults to 1", rps: 5, burst: 0, want: &ReamazeClientOptions{RateLimit: &ReamazeRateLimit{RequestsPerSecond: 5, Burst: 1}}},
+		{name: "Testing zero rate disables limiter", rps: 0, burst: 10, want: &ReamazeClientOptions{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &ReamazeClientOptions{}
+			WithRateLimit(tt.rps, tt.burst).Apply(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithRateLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rateLimiter_reserve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+	// burst of two requests goes through immediately
+	for i := 0; i < 2; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Errorf("rateLimiter.reserve() = %v, want 0", got)
+		}
+	}
+	// third and fourth have to wait for tokens
+	if got := l.reserve(); got != 500*time.Millisecond {
+		t.Errorf("rateLimiter.reserve() = %v, want %v", got, 500*time.Millisecond)
+	}
+	if got := l.reserve(); got != time.Second {
+		t.Errorf("rateLimiter.reserve() = %v, want %v", got, time.Second)
+	}
+	// after enough time bucket is refilled but never above burst
+	now = now.Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Errorf("rateLimiter.reserve() = %v, want 0", got)
+		}
+	}
+	if got := l.reserve(); got == 0 {
+		t.Errorf("rateLimiter.reserve() = 0, want delay")
+	}
+}
+
+func Test_rateLimiter_Wait(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("rateLimiter.Wait() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("rateLimiter.Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_reamazeRequestRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	c := &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy", rateLimiter: newRateLimiter(1000, 5), httpClient: &http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "Status OK",
+				Body:       io.NopCloser(strings.NewReader(`{"status":"ok"}`)),
+			}
+		}),
+	}}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.reamazeRequest(context.Background(), http.MethodGet, "/api/v1/conversations", []byte{}); err != nil {
+				t.Errorf("Client.reamazeRequest() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 20 {
+		t.Errorf("Client.reamazeRequest() calls = %v, want 20", calls)
+	}
+}
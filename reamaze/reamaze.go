@@ -2,22 +2,110 @@ package reamaze
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"errors"
 	"io"
 	"net/http"
 	"net/mail"
+	"net/url"
+	"strings"
+	"time"
 )
 
 // Reamaze Client
 type Client struct {
-	baseURL    string
-	auth       string
-	httpClient *http.Client
+	baseURL     string
+	auth        string
+	userAgent   string
+	httpClient  *http.Client
+	retryPolicy *RetryPolicy
+	rateLimiter *rateLimiter
+}
+
+type ReamazeHTTPClient struct {
+	Client *http.Client
+}
+type ReamazeBaseURL string
+type ReamazeUserAgent string
+type ReamazeTimeout time.Duration
+
+// ClientOption configures the Client created by NewClient
+type ClientOption interface {
+	Apply(*ReamazeClientOptions)
+}
+
+type ReamazeClientOptions struct {
+	HTTPClient  *http.Client
+	BaseURL     string
+	UserAgent   string
+	Timeout     time.Duration
+	RetryPolicy *RetryPolicy
+	RateLimit   *ReamazeRateLimit
+}
+
+func (w ReamazeHTTPClient) Apply(o *ReamazeClientOptions) {
+	if w.Client != nil {
+		o.HTTPClient = w.Client
+	}
+}
+
+func (w ReamazeBaseURL) Apply(o *ReamazeClientOptions) {
+	if len(w) > 0 {
+		o.BaseURL = strings.TrimRight(string(w), "/")
+	}
+}
+
+func (w ReamazeUserAgent) Apply(o *ReamazeClientOptions) {
+	if len(w) > 0 {
+		o.UserAgent = string(w)
+	}
+}
+
+func (w ReamazeTimeout) Apply(o *ReamazeClientOptions) {
+	if w > 0 {
+		o.Timeout = time.Duration(w)
+	}
+}
+
+// WithHTTPClient makes the Client send its requests through the given http.Client, e.g. one configured with a proxy
+func WithHTTPClient(client *http.Client) ReamazeHTTPClient {
+	return ReamazeHTTPClient{Client: client}
+}
+
+// WithBaseURL overrides the default https://<brand>.reamaze.io base URL, e.g. to point the Client at a local test server
+func WithBaseURL(baseURL string) ReamazeBaseURL {
+	return ReamazeBaseURL(baseURL)
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ReamazeUserAgent {
+	return ReamazeUserAgent(userAgent)
+}
+
+// WithTimeout sets the overall timeout of every request made by the Client
+func WithTimeout(timeout time.Duration) ReamazeTimeout {
+	return ReamazeTimeout(timeout)
+}
+
+func newClientSettings(opts []ClientOption) (*ReamazeClientOptions, error) {
+	var o ReamazeClientOptions
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	// checking if we have correct base URL
+	if len(o.BaseURL) > 0 {
+		u, err := url.Parse(o.BaseURL)
+		if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+			return nil, errors.New("incorrect base URL, please provide absolute URL e.g. https://brand.reamaze.io")
+		}
+	}
+	return &o, nil
 }
 
 // NewClient creates a new re:amaze client that uses the given workspace with user and pass.
-func NewClient(email, apiToken, brand string) (*Client, error) {
+// Optional parameters WithHTTPClient(*http.Client), WithBaseURL(string), WithUserAgent(string), WithTimeout(time.Duration), WithRetryPolicy(RetryPolicy), WithRateLimit(float64, int)
+func NewClient(email, apiToken, brand string, opts ...ClientOption) (*Client, error) {
 	// Checking email
 	if len(email) == 0 {
 		return nil, errors.New("email address cannot be empty")
@@ -34,39 +122,98 @@ func NewClient(email, apiToken, brand string) (*Client, error) {
 	if len(brand) == 0 {
 		return nil, errors.New("brand cannot be empty")
 	}
+	settings, err := newClientSettings(opts)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := "https://" + brand + ".reamaze.io"
+	if len(settings.BaseURL) > 0 {
+		baseURL = settings.BaseURL
+	}
+	httpClient := &http.Client{}
+	if settings.HTTPClient != nil {
+		httpClient = settings.HTTPClient
+	}
+	// we copy the http client so that setting the timeout doesn't change the caller's client
+	if settings.Timeout > 0 {
+		timeoutClient := *httpClient
+		timeoutClient.Timeout = settings.Timeout
+		httpClient = &timeoutClient
+	}
+	var limiter *rateLimiter
+	if settings.RateLimit != nil {
+		limiter = newRateLimiter(settings.RateLimit.RequestsPerSecond, settings.RateLimit.Burst)
+	}
 	// encoding email:apiToken using base64
 	sEnc := base64.StdEncoding.EncodeToString([]byte(email + ":" + apiToken))
 	return &Client{
-		baseURL:    "https://" + brand + ".reamaze.io",
-		auth:       sEnc,
-		httpClient: &http.Client{},
+		baseURL:     baseURL,
+		auth:        sEnc,
+		userAgent:   settings.UserAgent,
+		httpClient:  httpClient,
+		retryPolicy: settings.RetryPolicy,
+		rateLimiter: limiter,
 	}, nil
 }
 
 // reamazeRequset is a wrapper on http client to authenticate and set proper headers
-func (c *Client) reamazeRequest(method string, endpoint string, payload []byte) ([]byte, error) {
-	// Setting up request
-	req, err := http.NewRequest(method, c.baseURL+endpoint, bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, err
+// The request is bound to ctx so that cancellation and deadlines propagate to the http client.
+// When the Client has RetryPolicy set, failed requests are repeated according to that policy.
+// When the Client has rate limit set, every attempt waits for its turn first.
+func (c *Client) reamazeRequest(ctx context.Context, method string, endpoint string, payload []byte) ([]byte, error) {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		// Setting up request, we need a new one for every attempt as the body is consumed
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, bytes.NewBuffer(payload))
+		if err != nil {
+			return nil, err
+		}
+		bodyData, err := c.doRequest(req, method, endpoint)
+		if err == nil || c.retryPolicy == nil {
+			return bodyData, err
+		}
+		// checking if we are allowed to make another attempt
+		if attempt >= c.retryPolicy.MaxAttempts || !c.retryPolicy.retryable(method, err) {
+			return nil, err
+		}
+		delay := c.retryPolicy.delay(attempt, err)
+		if c.retryPolicy.MaxElapsed > 0 && time.Since(start)+delay > c.retryPolicy.MaxElapsed {
+			return nil, err
+		}
+		// the caller gave up while waiting, returning the context error along with the last failure
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return nil, errors.Join(sleepErr, err)
+		}
 	}
+}
+
+// doRequest executes single request to reamaze and checks the response status code
+func (c *Client) doRequest(req *http.Request, method string, endpoint string) ([]byte, error) {
 	// Setting HTTP headers
 	req.Header.Add("Authorization", "Basic "+c.auth)
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
+	if len(c.userAgent) > 0 {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 	// Executing request to reamaze
 	res, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
-	// Checking if we have response status code within acceptable numbers 200-299
-	if res.StatusCode >= 300 || res.StatusCode < 200 {
-		return nil, errors.New(res.Status)
-	}
 	bodyData, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
+	// Checking if we have response status code within acceptable numbers 200-299
+	if res.StatusCode >= 300 || res.StatusCode < 200 {
+		return nil, newAPIError(method, endpoint, res, bodyData)
+	}
 	return bodyData, nil
 }
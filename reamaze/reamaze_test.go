@@ -1,11 +1,14 @@
 package reamaze
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 type RoundTripFunc func(req *http.Request) *http.Response
@@ -19,7 +22,9 @@ func TestNewClient(t *testing.T) {
 		email    string
 		apiToken string
 		brand    string
+		opts     []ClientOption
 	}
+	customHTTPClient := &http.Client{}
 	tests := []struct {
 		name    string
 		args    args
@@ -56,11 +61,35 @@ func TestNewClient(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "Testing custom base URL and user agent",
+			args:    args{email: "test@example.com", apiToken: "something", brand: "brand", opts: []ClientOption{WithBaseURL("http://127.0.0.1:8080/"), WithUserAgent("dummy/1.0")}},
+			want:    &Client{baseURL: "http://127.0.0.1:8080", auth: "dGVzdEBleGFtcGxlLmNvbTpzb21ldGhpbmc=", userAgent: "dummy/1.0", httpClient: &http.Client{}},
+			wantErr: false,
+		},
+		{
+			name:    "Testing custom http client",
+			args:    args{email: "test@example.com", apiToken: "something", brand: "brand", opts: []ClientOption{WithHTTPClient(customHTTPClient)}},
+			want:    &Client{baseURL: "https://brand.reamaze.io", auth: "dGVzdEBleGFtcGxlLmNvbTpzb21ldGhpbmc=", httpClient: customHTTPClient},
+			wantErr: false,
+		},
+		{
+			name:    "Testing timeout",
+			args:    args{email: "test@example.com", apiToken: "something", brand: "brand", opts: []ClientOption{WithTimeout(5 * time.Second), WithHTTPClient(customHTTPClient)}},
+			want:    &Client{baseURL: "https://brand.reamaze.io", auth: "dGVzdEBleGFtcGxlLmNvbTpzb21ldGhpbmc=", httpClient: &http.Client{Timeout: 5 * time.Second}},
+			wantErr: false,
+		},
+		{
+			name:    "Testing invalid base URL",
+			args:    args{email: "test@example.com", apiToken: "something", brand: "brand", opts: []ClientOption{WithBaseURL("brand.reamaze.io")}},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewClient(tt.args.email, tt.args.apiToken, tt.args.brand)
+			got, err := NewClient(tt.args.email, tt.args.apiToken, tt.args.brand, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -70,6 +99,30 @@ func TestNewClient(t *testing.T) {
 			}
 		})
 	}
+	if customHTTPClient.Timeout != 0 {
+		t.Errorf("NewClient() WithTimeout modified the provided http.Client")
+	}
+}
+
+func TestClient_reamazeRequestUserAgent(t *testing.T) {
+	c, err := NewClient("test@example.com", "something", "brand", WithUserAgent("dummy/1.0"), WithHTTPClient(&http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			if req.Header.Get("User-Agent") != "dummy/1.0" {
+				t.Errorf("Client.reamazeRequest() User-Agent = %v, want %v", req.Header.Get("User-Agent"), "dummy/1.0")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "Status OK",
+				Body:       io.NopCloser(strings.NewReader(`{"status":"ok"}`)),
+			}
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.reamazeRequest(context.Background(), http.MethodGet, "/api/v1/conversations", []byte{}); err != nil {
+		t.Errorf("Client.reamazeRequest() error = %v", err)
+	}
 }
 
 func TestClient_reamazeRequest(t *testing.T) {
@@ -80,6 +133,7 @@ func TestClient_reamazeRequest(t *testing.T) {
 		httpClient *http.Client
 	}
 	type args struct {
+		ctx      context.Context
 		method   string
 		endpoint string
 		payload  []byte
@@ -102,7 +156,7 @@ func TestClient_reamazeRequest(t *testing.T) {
 					}
 				}),
 			}},
-			args:    args{method: http.MethodPost, endpoint: "/api/v1/conversations", payload: []byte{}},
+			args:    args{ctx: context.Background(), method: http.MethodPost, endpoint: "/api/v1/conversations", payload: []byte{}},
 			want:    nil,
 			wantErr: true,
 		},
@@ -117,7 +171,7 @@ func TestClient_reamazeRequest(t *testing.T) {
 					}
 				}),
 			}},
-			args:    args{method: http.MethodPost, endpoint: "/api/v1/conversations", payload: []byte{}},
+			args:    args{ctx: context.Background(), method: http.MethodPost, endpoint: "/api/v1/conversations", payload: []byte{}},
 			want:    []byte{123, 34, 115, 116, 97, 116, 117, 115, 34, 58, 34, 111, 107, 34, 125},
 			wantErr: false,
 		},
@@ -132,7 +186,7 @@ func TestClient_reamazeRequest(t *testing.T) {
 					}
 				}),
 			}},
-			args:    args{method: http.MethodPost, endpoint: "/api/v1/conversations", payload: []byte{}},
+			args:    args{ctx: context.Background(), method: http.MethodPost, endpoint: "/api/v1/conversations", payload: []byte{}},
 			want:    nil,
 			wantErr: true,
 		},
@@ -144,7 +198,7 @@ func TestClient_reamazeRequest(t *testing.T) {
 				auth:       tt.fields.auth,
 				httpClient: tt.fields.httpClient,
 			}
-			got, err := c.reamazeRequest(tt.args.method, tt.args.endpoint, tt.args.payload)
+			got, err := c.reamazeRequest(tt.args.ctx, tt.args.method, tt.args.endpoint, tt.args.payload)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Client.reamazeRequest() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -155,3 +209,61 @@ func TestClient_reamazeRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_reamazeRequestContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "dummy")
+	c := &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			if req.Context().Value(ctxKey{}) != "dummy" {
+				t.Errorf("Client.reamazeRequest() context was not propagated to the request")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "Status OK",
+				Body:       io.NopCloser(strings.NewReader(`{"status":"ok"}`)),
+			}
+		}),
+	}}
+	if _, err := c.reamazeRequest(ctx, http.MethodGet, "/api/v1/conversations", []byte{}); err != nil {
+		t.Errorf("Client.reamazeRequest() error = %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.httpClient = &http.Client{}
+	if _, err := c.reamazeRequest(canceled, http.MethodGet, "/api/v1/conversations", []byte{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Client.reamazeRequest() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestClient_reamazeRequestCanceledDuringRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	attempts := 0
+	c := &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy",
+		retryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour},
+		httpClient: &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				attempts++
+				// giving up while the client waits before the retry
+				time.AfterFunc(10*time.Millisecond, cancel)
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Status:     "503 Service Unavailable",
+					Body:       io.NopCloser(strings.NewReader(`{}`)),
+				}
+			}),
+		}}
+	_, err := c.reamazeRequest(ctx, http.MethodGet, "/api/v1/conversations", []byte{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Client.reamazeRequest() error = %v, want %v", err, context.Canceled)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Client.reamazeRequest() error = %v, want the last APIError as well", err)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
@@ -0,0 +1,1145 @@
+// Code generated by go run ./internal/genmock; DO NOT EDIT.
+
+package reamazemock
+
+import (
+	"context"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// Mock implements reamaze.API, every method calls the function field named after it with Func suffix.
+// Methods without context fall back to their WithContext function field called with context.Background(),
+// and return ErrNotMocked when neither is set. Every call is recorded, see Calls.
+type Mock struct {
+	recorder
+
+	GetArticlesFunc                           func(o ...reamaze.ArticlesOption) (*reamaze.GetArticlesResponse, error)
+	GetArticlesWithContextFunc                func(ctx context.Context, o ...reamaze.ArticlesOption) (*reamaze.GetArticlesResponse, error)
+	GetArticleFunc                            func(slug string) (*reamaze.GetArticleResponse, error)
+	GetArticleWithContextFunc                 func(ctx context.Context, slug string) (*reamaze.GetArticleResponse, error)
+	CreateArticleFunc                         func(req *reamaze.CreateArticleRequest) (*reamaze.CreateArticleResponse, error)
+	CreateArticleWithContextFunc              func(ctx context.Context, req *reamaze.CreateArticleRequest) (*reamaze.CreateArticleResponse, error)
+	UpdateArticleFunc                         func(slug string, req *reamaze.UpdateArticleRequest) (*reamaze.UpdateArticleResponse, error)
+	UpdateArticleWithContextFunc              func(ctx context.Context, slug string, req *reamaze.UpdateArticleRequest) (*reamaze.UpdateArticleResponse, error)
+	GetChannelsFunc                           func() (*reamaze.GetChannelsResponse, error)
+	GetChannelsWithContextFunc                func(ctx context.Context) (*reamaze.GetChannelsResponse, error)
+	GetChannelFunc                            func(slug string) (*reamaze.GetChannelResponse, error)
+	GetChannelWithContextFunc                 func(ctx context.Context, slug string) (*reamaze.GetChannelResponse, error)
+	GetContactsFunc                           func(o ...reamaze.ContactsOption) (*reamaze.GetContactsResponse, error)
+	GetContactsWithContextFunc                func(ctx context.Context, o ...reamaze.ContactsOption) (*reamaze.GetContactsResponse, error)
+	GetContactFunc                            func(identifier string) (*reamaze.GetContactResponse, error)
+	GetContactWithContextFunc                 func(ctx context.Context, identifier string) (*reamaze.GetContactResponse, error)
+	CreateContactFunc                         func(req *reamaze.CreateContactRequest) (*reamaze.GetContactResponse, error)
+	CreateContactWithContextFunc              func(ctx context.Context, req *reamaze.CreateContactRequest) (*reamaze.GetContactResponse, error)
+	UpdateContactFunc                         func(identifier string, req *reamaze.UpdateContactRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactResponse, error)
+	UpdateContactWithContextFunc              func(ctx context.Context, identifier string, req *reamaze.UpdateContactRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactResponse, error)
+	GetContactIdentitiesFunc                  func(identifier string) (*reamaze.GetContactIdentitiesResponse, error)
+	GetContactIdentitiesWithContextFunc       func(ctx context.Context, identifier string) (*reamaze.GetContactIdentitiesResponse, error)
+	CreateContactIdentitiesFunc               func(identifier string, req *reamaze.CreateContactIdentitiesRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactIdentitiesResponse, error)
+	CreateContactIdentitiesWithContextFunc    func(ctx context.Context, identifier string, req *reamaze.CreateContactIdentitiesRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactIdentitiesResponse, error)
+	GetConversationsFunc                      func(o ...reamaze.ConversationsOption) (*reamaze.GetConversationsResponse, error)
+	GetConversationsWithContextFunc           func(ctx context.Context, o ...reamaze.ConversationsOption) (*reamaze.GetConversationsResponse, error)
+	GetConversationFunc                       func(slug string) (*reamaze.GetConversationResponse, error)
+	GetConversationWithContextFunc            func(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error)
+	CreateConversationFunc                    func(req *reamaze.CreateConversationRequest) (*reamaze.CreateConversationResponse, error)
+	CreateConversationWithContextFunc         func(ctx context.Context, req *reamaze.CreateConversationRequest) (*reamaze.CreateConversationResponse, error)
+	UpdateConversationFunc                    func(slug string, req *reamaze.UpdateConversationRequest) (*reamaze.GetConversationResponse, error)
+	UpdateConversationWithContextFunc         func(ctx context.Context, slug string, req *reamaze.UpdateConversationRequest) (*reamaze.GetConversationResponse, error)
+	AssignConversationFunc                    func(slug string, staffEmail string) (*reamaze.GetConversationResponse, error)
+	AssignConversationWithContextFunc         func(ctx context.Context, slug string, staffEmail string) (*reamaze.GetConversationResponse, error)
+	AddConversationTagsFunc                   func(slug string, tags ...string) (*reamaze.GetConversationResponse, error)
+	AddConversationTagsWithContextFunc        func(ctx context.Context, slug string, tags ...string) (*reamaze.GetConversationResponse, error)
+	RemoveConversationTagsFunc                func(slug string, tags ...string) (*reamaze.GetConversationResponse, error)
+	RemoveConversationTagsWithContextFunc     func(ctx context.Context, slug string, tags ...string) (*reamaze.GetConversationResponse, error)
+	ResolveConversationFunc                   func(slug string) (*reamaze.GetConversationResponse, error)
+	ResolveConversationWithContextFunc        func(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error)
+	ReopenConversationFunc                    func(slug string) (*reamaze.GetConversationResponse, error)
+	ReopenConversationWithContextFunc         func(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error)
+	MarkConversationSpamFunc                  func(slug string) (*reamaze.GetConversationResponse, error)
+	MarkConversationSpamWithContextFunc       func(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error)
+	HoldConversationUntilFunc                 func(slug string, until time.Time) (*reamaze.GetConversationResponse, error)
+	HoldConversationUntilWithContextFunc      func(ctx context.Context, slug string, until time.Time) (*reamaze.GetConversationResponse, error)
+	MoveConversationToCategoryFunc            func(slug string, category string) (*reamaze.GetConversationResponse, error)
+	MoveConversationToCategoryWithContextFunc func(ctx context.Context, slug string, category string) (*reamaze.GetConversationResponse, error)
+	GetIncidentsFunc                          func() (*reamaze.GetIncidentsResponse, error)
+	GetIncidentsWithContextFunc               func(ctx context.Context) (*reamaze.GetIncidentsResponse, error)
+	GetIncidentFunc                           func(identifier string) (*reamaze.GetIncidentResponse, error)
+	GetIncidentWithContextFunc                func(ctx context.Context, identifier string) (*reamaze.GetIncidentResponse, error)
+	CreateIncidentFunc                        func(req *reamaze.CreateIncidentRequest) (*reamaze.CreateIncidentResponse, error)
+	CreateIncidentWithContextFunc             func(ctx context.Context, req *reamaze.CreateIncidentRequest) (*reamaze.CreateIncidentResponse, error)
+	UpdateIncidentFunc                        func(identifier string, req *reamaze.UpdateIncidentRequest) (*reamaze.UpdateIncidentResponse, error)
+	UpdateIncidentWithContextFunc             func(ctx context.Context, identifier string, req *reamaze.UpdateIncidentRequest) (*reamaze.UpdateIncidentResponse, error)
+	GetMessagesFunc                           func(o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error)
+	GetMessagesWithContextFunc                func(ctx context.Context, o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error)
+	GetConversationMessagesFunc               func(slug string, o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error)
+	GetConversationMessagesWithContextFunc    func(ctx context.Context, slug string, o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error)
+	CreateMessageFunc                         func(slug string, req *reamaze.CreateMessageRequest) (*reamaze.CreateMessageResponse, error)
+	CreateMessageWithContextFunc              func(ctx context.Context, slug string, req *reamaze.CreateMessageRequest) (*reamaze.CreateMessageResponse, error)
+	ReplyToConversationFunc                   func(slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error)
+	ReplyToConversationWithContextFunc        func(ctx context.Context, slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error)
+	AddInternalNoteFunc                       func(slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error)
+	AddInternalNoteWithContextFunc            func(ctx context.Context, slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error)
+	GetNotesFunc                              func(identifier string) (*reamaze.GetNotesResponse, error)
+	GetNotesWithContextFunc                   func(ctx context.Context, identifier string) (*reamaze.GetNotesResponse, error)
+	CreateNoteFunc                            func(identifier string, req *reamaze.CreateNoteRequest) (*reamaze.CreateNoteResponse, error)
+	CreateNoteWithContextFunc                 func(ctx context.Context, identifier string, req *reamaze.CreateNoteRequest) (*reamaze.CreateNoteResponse, error)
+	UpdateNoteFunc                            func(identifier string, noteID string, req *reamaze.UpdateNoteRequest) (*reamaze.UpdateNoteResponse, error)
+	UpdateNoteWithContextFunc                 func(ctx context.Context, identifier string, noteID string, req *reamaze.UpdateNoteRequest) (*reamaze.UpdateNoteResponse, error)
+	DeleteNoteFunc                            func(identifier string, noteID string) (*reamaze.DeleteNoteResponse, error)
+	DeleteNoteWithContextFunc                 func(ctx context.Context, identifier string, noteID string) (*reamaze.DeleteNoteResponse, error)
+	GetReportsVolumeFunc                      func(o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error)
+	GetReportsVolumeWithContextFunc           func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error)
+	GetReportsResponseTimeFunc                func(o ...reamaze.ReportsOption) (*reamaze.GetReportsResponseTimeRespone, error)
+	GetReportsResponseTimeWithContextFunc     func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsResponseTimeRespone, error)
+	GetReportsStaffFunc                       func(o ...reamaze.ReportsOption) (*reamaze.GetReportsStaffResponse, error)
+	GetReportsStaffWithContextFunc            func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsStaffResponse, error)
+	GetReportsTagsFunc                        func(o ...reamaze.ReportsOption) (*reamaze.GetReportsTagsResponse, error)
+	GetReportsTagsWithContextFunc             func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsTagsResponse, error)
+	GetReportsChannelSummaryFunc              func(o ...reamaze.ReportsOption) (*reamaze.GetReportsChannelSummaryResponse, error)
+	GetReportsChannelSummaryWithContextFunc   func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsChannelSummaryResponse, error)
+	GetResponseTemplatesFunc                  func() (*reamaze.GetResponseTemplatesResponse, error)
+	GetResponseTemplatesWithContextFunc       func(ctx context.Context) (*reamaze.GetResponseTemplatesResponse, error)
+	GetResponseTemplateFunc                   func(identifier string) (*reamaze.GetResponseTemplateResponse, error)
+	GetResponseTemplateWithContextFunc        func(ctx context.Context, identifier string) (*reamaze.GetResponseTemplateResponse, error)
+	CreateResponseTemplateFunc                func(req *reamaze.CreateResponseTemplateRequest) (*reamaze.CreateResponseTemplateResponse, error)
+	CreateResponseTemplateWithContextFunc     func(ctx context.Context, req *reamaze.CreateResponseTemplateRequest) (*reamaze.CreateResponseTemplateResponse, error)
+	UpdateResponseTemplateFunc                func(identifier string, req *reamaze.UpdateResponseTemplateRequest) (*reamaze.UpdateResponseTemplateResponse, error)
+	UpdateResponseTemplateWithContextFunc     func(ctx context.Context, identifier string, req *reamaze.UpdateResponseTemplateRequest) (*reamaze.UpdateResponseTemplateResponse, error)
+	GetStaffFunc                              func(o ...reamaze.StaffOption) (*reamaze.GetStaffResponse, error)
+	GetStaffWithContextFunc                   func(ctx context.Context, o ...reamaze.StaffOption) (*reamaze.GetStaffResponse, error)
+	CreateStaffFunc                           func(req *reamaze.CreateStaffRequest) (*reamaze.CreateStaffResponse, error)
+	CreateStaffWithContextFunc                func(ctx context.Context, req *reamaze.CreateStaffRequest) (*reamaze.CreateStaffResponse, error)
+	GetSystemsFunc                            func() (*reamaze.GetSystemsResponse, error)
+	GetSystemsWithContextFunc                 func(ctx context.Context) (*reamaze.GetSystemsResponse, error)
+}
+
+// GetArticles calls GetArticlesFunc, or GetArticlesWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetArticles(o ...reamaze.ArticlesOption) (*reamaze.GetArticlesResponse, error) {
+	m.record("GetArticles", o)
+	if m.GetArticlesFunc != nil {
+		return m.GetArticlesFunc(o...)
+	}
+	if m.GetArticlesWithContextFunc != nil {
+		return m.GetArticlesWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetArticlesWithContext calls GetArticlesWithContextFunc
+func (m *Mock) GetArticlesWithContext(ctx context.Context, o ...reamaze.ArticlesOption) (*reamaze.GetArticlesResponse, error) {
+	m.record("GetArticlesWithContext", o)
+	if m.GetArticlesWithContextFunc != nil {
+		return m.GetArticlesWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetArticle calls GetArticleFunc, or GetArticleWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetArticle(slug string) (*reamaze.GetArticleResponse, error) {
+	m.record("GetArticle", slug)
+	if m.GetArticleFunc != nil {
+		return m.GetArticleFunc(slug)
+	}
+	if m.GetArticleWithContextFunc != nil {
+		return m.GetArticleWithContextFunc(context.Background(), slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetArticleWithContext calls GetArticleWithContextFunc
+func (m *Mock) GetArticleWithContext(ctx context.Context, slug string) (*reamaze.GetArticleResponse, error) {
+	m.record("GetArticleWithContext", slug)
+	if m.GetArticleWithContextFunc != nil {
+		return m.GetArticleWithContextFunc(ctx, slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateArticle calls CreateArticleFunc, or CreateArticleWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateArticle(req *reamaze.CreateArticleRequest) (*reamaze.CreateArticleResponse, error) {
+	m.record("CreateArticle", req)
+	if m.CreateArticleFunc != nil {
+		return m.CreateArticleFunc(req)
+	}
+	if m.CreateArticleWithContextFunc != nil {
+		return m.CreateArticleWithContextFunc(context.Background(), req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateArticleWithContext calls CreateArticleWithContextFunc
+func (m *Mock) CreateArticleWithContext(ctx context.Context, req *reamaze.CreateArticleRequest) (*reamaze.CreateArticleResponse, error) {
+	m.record("CreateArticleWithContext", req)
+	if m.CreateArticleWithContextFunc != nil {
+		return m.CreateArticleWithContextFunc(ctx, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateArticle calls UpdateArticleFunc, or UpdateArticleWithContextFunc with context.Background() when it's nil
+func (m *Mock) UpdateArticle(slug string, req *reamaze.UpdateArticleRequest) (*reamaze.UpdateArticleResponse, error) {
+	m.record("UpdateArticle", slug, req)
+	if m.UpdateArticleFunc != nil {
+		return m.UpdateArticleFunc(slug, req)
+	}
+	if m.UpdateArticleWithContextFunc != nil {
+		return m.UpdateArticleWithContextFunc(context.Background(), slug, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateArticleWithContext calls UpdateArticleWithContextFunc
+func (m *Mock) UpdateArticleWithContext(ctx context.Context, slug string, req *reamaze.UpdateArticleRequest) (*reamaze.UpdateArticleResponse, error) {
+	m.record("UpdateArticleWithContext", slug, req)
+	if m.UpdateArticleWithContextFunc != nil {
+		return m.UpdateArticleWithContextFunc(ctx, slug, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetChannels calls GetChannelsFunc, or GetChannelsWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetChannels() (*reamaze.GetChannelsResponse, error) {
+	m.record("GetChannels")
+	if m.GetChannelsFunc != nil {
+		return m.GetChannelsFunc()
+	}
+	if m.GetChannelsWithContextFunc != nil {
+		return m.GetChannelsWithContextFunc(context.Background())
+	}
+	return nil, ErrNotMocked
+}
+
+// GetChannelsWithContext calls GetChannelsWithContextFunc
+func (m *Mock) GetChannelsWithContext(ctx context.Context) (*reamaze.GetChannelsResponse, error) {
+	m.record("GetChannelsWithContext")
+	if m.GetChannelsWithContextFunc != nil {
+		return m.GetChannelsWithContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetChannel calls GetChannelFunc, or GetChannelWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetChannel(slug string) (*reamaze.GetChannelResponse, error) {
+	m.record("GetChannel", slug)
+	if m.GetChannelFunc != nil {
+		return m.GetChannelFunc(slug)
+	}
+	if m.GetChannelWithContextFunc != nil {
+		return m.GetChannelWithContextFunc(context.Background(), slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetChannelWithContext calls GetChannelWithContextFunc
+func (m *Mock) GetChannelWithContext(ctx context.Context, slug string) (*reamaze.GetChannelResponse, error) {
+	m.record("GetChannelWithContext", slug)
+	if m.GetChannelWithContextFunc != nil {
+		return m.GetChannelWithContextFunc(ctx, slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetContacts calls GetContactsFunc, or GetContactsWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetContacts(o ...reamaze.ContactsOption) (*reamaze.GetContactsResponse, error) {
+	m.record("GetContacts", o)
+	if m.GetContactsFunc != nil {
+		return m.GetContactsFunc(o...)
+	}
+	if m.GetContactsWithContextFunc != nil {
+		return m.GetContactsWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetContactsWithContext calls GetContactsWithContextFunc
+func (m *Mock) GetContactsWithContext(ctx context.Context, o ...reamaze.ContactsOption) (*reamaze.GetContactsResponse, error) {
+	m.record("GetContactsWithContext", o)
+	if m.GetContactsWithContextFunc != nil {
+		return m.GetContactsWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetContact calls GetContactFunc, or GetContactWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetContact(identifier string) (*reamaze.GetContactResponse, error) {
+	m.record("GetContact", identifier)
+	if m.GetContactFunc != nil {
+		return m.GetContactFunc(identifier)
+	}
+	if m.GetContactWithContextFunc != nil {
+		return m.GetContactWithContextFunc(context.Background(), identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetContactWithContext calls GetContactWithContextFunc
+func (m *Mock) GetContactWithContext(ctx context.Context, identifier string) (*reamaze.GetContactResponse, error) {
+	m.record("GetContactWithContext", identifier)
+	if m.GetContactWithContextFunc != nil {
+		return m.GetContactWithContextFunc(ctx, identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateContact calls CreateContactFunc, or CreateContactWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateContact(req *reamaze.CreateContactRequest) (*reamaze.GetContactResponse, error) {
+	m.record("CreateContact", req)
+	if m.CreateContactFunc != nil {
+		return m.CreateContactFunc(req)
+	}
+	if m.CreateContactWithContextFunc != nil {
+		return m.CreateContactWithContextFunc(context.Background(), req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateContactWithContext calls CreateContactWithContextFunc
+func (m *Mock) CreateContactWithContext(ctx context.Context, req *reamaze.CreateContactRequest) (*reamaze.GetContactResponse, error) {
+	m.record("CreateContactWithContext", req)
+	if m.CreateContactWithContextFunc != nil {
+		return m.CreateContactWithContextFunc(ctx, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateContact calls UpdateContactFunc, or UpdateContactWithContextFunc with context.Background() when it's nil
+func (m *Mock) UpdateContact(identifier string, req *reamaze.UpdateContactRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactResponse, error) {
+	m.record("UpdateContact", identifier, req, identifierType)
+	if m.UpdateContactFunc != nil {
+		return m.UpdateContactFunc(identifier, req, identifierType...)
+	}
+	if m.UpdateContactWithContextFunc != nil {
+		return m.UpdateContactWithContextFunc(context.Background(), identifier, req, identifierType...)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateContactWithContext calls UpdateContactWithContextFunc
+func (m *Mock) UpdateContactWithContext(ctx context.Context, identifier string, req *reamaze.UpdateContactRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactResponse, error) {
+	m.record("UpdateContactWithContext", identifier, req, identifierType)
+	if m.UpdateContactWithContextFunc != nil {
+		return m.UpdateContactWithContextFunc(ctx, identifier, req, identifierType...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetContactIdentities calls GetContactIdentitiesFunc, or GetContactIdentitiesWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetContactIdentities(identifier string) (*reamaze.GetContactIdentitiesResponse, error) {
+	m.record("GetContactIdentities", identifier)
+	if m.GetContactIdentitiesFunc != nil {
+		return m.GetContactIdentitiesFunc(identifier)
+	}
+	if m.GetContactIdentitiesWithContextFunc != nil {
+		return m.GetContactIdentitiesWithContextFunc(context.Background(), identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetContactIdentitiesWithContext calls GetContactIdentitiesWithContextFunc
+func (m *Mock) GetContactIdentitiesWithContext(ctx context.Context, identifier string) (*reamaze.GetContactIdentitiesResponse, error) {
+	m.record("GetContactIdentitiesWithContext", identifier)
+	if m.GetContactIdentitiesWithContextFunc != nil {
+		return m.GetContactIdentitiesWithContextFunc(ctx, identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateContactIdentities calls CreateContactIdentitiesFunc, or CreateContactIdentitiesWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateContactIdentities(identifier string, req *reamaze.CreateContactIdentitiesRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactIdentitiesResponse, error) {
+	m.record("CreateContactIdentities", identifier, req, identifierType)
+	if m.CreateContactIdentitiesFunc != nil {
+		return m.CreateContactIdentitiesFunc(identifier, req, identifierType...)
+	}
+	if m.CreateContactIdentitiesWithContextFunc != nil {
+		return m.CreateContactIdentitiesWithContextFunc(context.Background(), identifier, req, identifierType...)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateContactIdentitiesWithContext calls CreateContactIdentitiesWithContextFunc
+func (m *Mock) CreateContactIdentitiesWithContext(ctx context.Context, identifier string, req *reamaze.CreateContactIdentitiesRequest, identifierType ...reamaze.ReamazeIdentifier) (*reamaze.GetContactIdentitiesResponse, error) {
+	m.record("CreateContactIdentitiesWithContext", identifier, req, identifierType)
+	if m.CreateContactIdentitiesWithContextFunc != nil {
+		return m.CreateContactIdentitiesWithContextFunc(ctx, identifier, req, identifierType...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetConversations calls GetConversationsFunc, or GetConversationsWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetConversations(o ...reamaze.ConversationsOption) (*reamaze.GetConversationsResponse, error) {
+	m.record("GetConversations", o)
+	if m.GetConversationsFunc != nil {
+		return m.GetConversationsFunc(o...)
+	}
+	if m.GetConversationsWithContextFunc != nil {
+		return m.GetConversationsWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetConversationsWithContext calls GetConversationsWithContextFunc
+func (m *Mock) GetConversationsWithContext(ctx context.Context, o ...reamaze.ConversationsOption) (*reamaze.GetConversationsResponse, error) {
+	m.record("GetConversationsWithContext", o)
+	if m.GetConversationsWithContextFunc != nil {
+		return m.GetConversationsWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetConversation calls GetConversationFunc, or GetConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetConversation(slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("GetConversation", slug)
+	if m.GetConversationFunc != nil {
+		return m.GetConversationFunc(slug)
+	}
+	if m.GetConversationWithContextFunc != nil {
+		return m.GetConversationWithContextFunc(context.Background(), slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetConversationWithContext calls GetConversationWithContextFunc
+func (m *Mock) GetConversationWithContext(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("GetConversationWithContext", slug)
+	if m.GetConversationWithContextFunc != nil {
+		return m.GetConversationWithContextFunc(ctx, slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateConversation calls CreateConversationFunc, or CreateConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateConversation(req *reamaze.CreateConversationRequest) (*reamaze.CreateConversationResponse, error) {
+	m.record("CreateConversation", req)
+	if m.CreateConversationFunc != nil {
+		return m.CreateConversationFunc(req)
+	}
+	if m.CreateConversationWithContextFunc != nil {
+		return m.CreateConversationWithContextFunc(context.Background(), req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateConversationWithContext calls CreateConversationWithContextFunc
+func (m *Mock) CreateConversationWithContext(ctx context.Context, req *reamaze.CreateConversationRequest) (*reamaze.CreateConversationResponse, error) {
+	m.record("CreateConversationWithContext", req)
+	if m.CreateConversationWithContextFunc != nil {
+		return m.CreateConversationWithContextFunc(ctx, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateConversation calls UpdateConversationFunc, or UpdateConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) UpdateConversation(slug string, req *reamaze.UpdateConversationRequest) (*reamaze.GetConversationResponse, error) {
+	m.record("UpdateConversation", slug, req)
+	if m.UpdateConversationFunc != nil {
+		return m.UpdateConversationFunc(slug, req)
+	}
+	if m.UpdateConversationWithContextFunc != nil {
+		return m.UpdateConversationWithContextFunc(context.Background(), slug, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateConversationWithContext calls UpdateConversationWithContextFunc
+func (m *Mock) UpdateConversationWithContext(ctx context.Context, slug string, req *reamaze.UpdateConversationRequest) (*reamaze.GetConversationResponse, error) {
+	m.record("UpdateConversationWithContext", slug, req)
+	if m.UpdateConversationWithContextFunc != nil {
+		return m.UpdateConversationWithContextFunc(ctx, slug, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// AssignConversation calls AssignConversationFunc, or AssignConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) AssignConversation(slug string, staffEmail string) (*reamaze.GetConversationResponse, error) {
+	m.record("AssignConversation", slug, staffEmail)
+	if m.AssignConversationFunc != nil {
+		return m.AssignConversationFunc(slug, staffEmail)
+	}
+	if m.AssignConversationWithContextFunc != nil {
+		return m.AssignConversationWithContextFunc(context.Background(), slug, staffEmail)
+	}
+	return nil, ErrNotMocked
+}
+
+// AssignConversationWithContext calls AssignConversationWithContextFunc
+func (m *Mock) AssignConversationWithContext(ctx context.Context, slug string, staffEmail string) (*reamaze.GetConversationResponse, error) {
+	m.record("AssignConversationWithContext", slug, staffEmail)
+	if m.AssignConversationWithContextFunc != nil {
+		return m.AssignConversationWithContextFunc(ctx, slug, staffEmail)
+	}
+	return nil, ErrNotMocked
+}
+
+// AddConversationTags calls AddConversationTagsFunc, or AddConversationTagsWithContextFunc with context.Background() when it's nil
+func (m *Mock) AddConversationTags(slug string, tags ...string) (*reamaze.GetConversationResponse, error) {
+	m.record("AddConversationTags", slug, tags)
+	if m.AddConversationTagsFunc != nil {
+		return m.AddConversationTagsFunc(slug, tags...)
+	}
+	if m.AddConversationTagsWithContextFunc != nil {
+		return m.AddConversationTagsWithContextFunc(context.Background(), slug, tags...)
+	}
+	return nil, ErrNotMocked
+}
+
+// AddConversationTagsWithContext calls AddConversationTagsWithContextFunc
+func (m *Mock) AddConversationTagsWithContext(ctx context.Context, slug string, tags ...string) (*reamaze.GetConversationResponse, error) {
+	m.record("AddConversationTagsWithContext", slug, tags)
+	if m.AddConversationTagsWithContextFunc != nil {
+		return m.AddConversationTagsWithContextFunc(ctx, slug, tags...)
+	}
+	return nil, ErrNotMocked
+}
+
+// RemoveConversationTags calls RemoveConversationTagsFunc, or RemoveConversationTagsWithContextFunc with context.Background() when it's nil
+func (m *Mock) RemoveConversationTags(slug string, tags ...string) (*reamaze.GetConversationResponse, error) {
+	m.record("RemoveConversationTags", slug, tags)
+	if m.RemoveConversationTagsFunc != nil {
+		return m.RemoveConversationTagsFunc(slug, tags...)
+	}
+	if m.RemoveConversationTagsWithContextFunc != nil {
+		return m.RemoveConversationTagsWithContextFunc(context.Background(), slug, tags...)
+	}
+	return nil, ErrNotMocked
+}
+
+// RemoveConversationTagsWithContext calls RemoveConversationTagsWithContextFunc
+func (m *Mock) RemoveConversationTagsWithContext(ctx context.Context, slug string, tags ...string) (*reamaze.GetConversationResponse, error) {
+	m.record("RemoveConversationTagsWithContext", slug, tags)
+	if m.RemoveConversationTagsWithContextFunc != nil {
+		return m.RemoveConversationTagsWithContextFunc(ctx, slug, tags...)
+	}
+	return nil, ErrNotMocked
+}
+
+// ResolveConversation calls ResolveConversationFunc, or ResolveConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) ResolveConversation(slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("ResolveConversation", slug)
+	if m.ResolveConversationFunc != nil {
+		return m.ResolveConversationFunc(slug)
+	}
+	if m.ResolveConversationWithContextFunc != nil {
+		return m.ResolveConversationWithContextFunc(context.Background(), slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// ResolveConversationWithContext calls ResolveConversationWithContextFunc
+func (m *Mock) ResolveConversationWithContext(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("ResolveConversationWithContext", slug)
+	if m.ResolveConversationWithContextFunc != nil {
+		return m.ResolveConversationWithContextFunc(ctx, slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// ReopenConversation calls ReopenConversationFunc, or ReopenConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) ReopenConversation(slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("ReopenConversation", slug)
+	if m.ReopenConversationFunc != nil {
+		return m.ReopenConversationFunc(slug)
+	}
+	if m.ReopenConversationWithContextFunc != nil {
+		return m.ReopenConversationWithContextFunc(context.Background(), slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// ReopenConversationWithContext calls ReopenConversationWithContextFunc
+func (m *Mock) ReopenConversationWithContext(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("ReopenConversationWithContext", slug)
+	if m.ReopenConversationWithContextFunc != nil {
+		return m.ReopenConversationWithContextFunc(ctx, slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// MarkConversationSpam calls MarkConversationSpamFunc, or MarkConversationSpamWithContextFunc with context.Background() when it's nil
+func (m *Mock) MarkConversationSpam(slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("MarkConversationSpam", slug)
+	if m.MarkConversationSpamFunc != nil {
+		return m.MarkConversationSpamFunc(slug)
+	}
+	if m.MarkConversationSpamWithContextFunc != nil {
+		return m.MarkConversationSpamWithContextFunc(context.Background(), slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// MarkConversationSpamWithContext calls MarkConversationSpamWithContextFunc
+func (m *Mock) MarkConversationSpamWithContext(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error) {
+	m.record("MarkConversationSpamWithContext", slug)
+	if m.MarkConversationSpamWithContextFunc != nil {
+		return m.MarkConversationSpamWithContextFunc(ctx, slug)
+	}
+	return nil, ErrNotMocked
+}
+
+// HoldConversationUntil calls HoldConversationUntilFunc, or HoldConversationUntilWithContextFunc with context.Background() when it's nil
+func (m *Mock) HoldConversationUntil(slug string, until time.Time) (*reamaze.GetConversationResponse, error) {
+	m.record("HoldConversationUntil", slug, until)
+	if m.HoldConversationUntilFunc != nil {
+		return m.HoldConversationUntilFunc(slug, until)
+	}
+	if m.HoldConversationUntilWithContextFunc != nil {
+		return m.HoldConversationUntilWithContextFunc(context.Background(), slug, until)
+	}
+	return nil, ErrNotMocked
+}
+
+// HoldConversationUntilWithContext calls HoldConversationUntilWithContextFunc
+func (m *Mock) HoldConversationUntilWithContext(ctx context.Context, slug string, until time.Time) (*reamaze.GetConversationResponse, error) {
+	m.record("HoldConversationUntilWithContext", slug, until)
+	if m.HoldConversationUntilWithContextFunc != nil {
+		return m.HoldConversationUntilWithContextFunc(ctx, slug, until)
+	}
+	return nil, ErrNotMocked
+}
+
+// MoveConversationToCategory calls MoveConversationToCategoryFunc, or MoveConversationToCategoryWithContextFunc with context.Background() when it's nil
+func (m *Mock) MoveConversationToCategory(slug string, category string) (*reamaze.GetConversationResponse, error) {
+	m.record("MoveConversationToCategory", slug, category)
+	if m.MoveConversationToCategoryFunc != nil {
+		return m.MoveConversationToCategoryFunc(slug, category)
+	}
+	if m.MoveConversationToCategoryWithContextFunc != nil {
+		return m.MoveConversationToCategoryWithContextFunc(context.Background(), slug, category)
+	}
+	return nil, ErrNotMocked
+}
+
+// MoveConversationToCategoryWithContext calls MoveConversationToCategoryWithContextFunc
+func (m *Mock) MoveConversationToCategoryWithContext(ctx context.Context, slug string, category string) (*reamaze.GetConversationResponse, error) {
+	m.record("MoveConversationToCategoryWithContext", slug, category)
+	if m.MoveConversationToCategoryWithContextFunc != nil {
+		return m.MoveConversationToCategoryWithContextFunc(ctx, slug, category)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetIncidents calls GetIncidentsFunc, or GetIncidentsWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetIncidents() (*reamaze.GetIncidentsResponse, error) {
+	m.record("GetIncidents")
+	if m.GetIncidentsFunc != nil {
+		return m.GetIncidentsFunc()
+	}
+	if m.GetIncidentsWithContextFunc != nil {
+		return m.GetIncidentsWithContextFunc(context.Background())
+	}
+	return nil, ErrNotMocked
+}
+
+// GetIncidentsWithContext calls GetIncidentsWithContextFunc
+func (m *Mock) GetIncidentsWithContext(ctx context.Context) (*reamaze.GetIncidentsResponse, error) {
+	m.record("GetIncidentsWithContext")
+	if m.GetIncidentsWithContextFunc != nil {
+		return m.GetIncidentsWithContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetIncident calls GetIncidentFunc, or GetIncidentWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetIncident(identifier string) (*reamaze.GetIncidentResponse, error) {
+	m.record("GetIncident", identifier)
+	if m.GetIncidentFunc != nil {
+		return m.GetIncidentFunc(identifier)
+	}
+	if m.GetIncidentWithContextFunc != nil {
+		return m.GetIncidentWithContextFunc(context.Background(), identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetIncidentWithContext calls GetIncidentWithContextFunc
+func (m *Mock) GetIncidentWithContext(ctx context.Context, identifier string) (*reamaze.GetIncidentResponse, error) {
+	m.record("GetIncidentWithContext", identifier)
+	if m.GetIncidentWithContextFunc != nil {
+		return m.GetIncidentWithContextFunc(ctx, identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateIncident calls CreateIncidentFunc, or CreateIncidentWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateIncident(req *reamaze.CreateIncidentRequest) (*reamaze.CreateIncidentResponse, error) {
+	m.record("CreateIncident", req)
+	if m.CreateIncidentFunc != nil {
+		return m.CreateIncidentFunc(req)
+	}
+	if m.CreateIncidentWithContextFunc != nil {
+		return m.CreateIncidentWithContextFunc(context.Background(), req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateIncidentWithContext calls CreateIncidentWithContextFunc
+func (m *Mock) CreateIncidentWithContext(ctx context.Context, req *reamaze.CreateIncidentRequest) (*reamaze.CreateIncidentResponse, error) {
+	m.record("CreateIncidentWithContext", req)
+	if m.CreateIncidentWithContextFunc != nil {
+		return m.CreateIncidentWithContextFunc(ctx, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateIncident calls UpdateIncidentFunc, or UpdateIncidentWithContextFunc with context.Background() when it's nil
+func (m *Mock) UpdateIncident(identifier string, req *reamaze.UpdateIncidentRequest) (*reamaze.UpdateIncidentResponse, error) {
+	m.record("UpdateIncident", identifier, req)
+	if m.UpdateIncidentFunc != nil {
+		return m.UpdateIncidentFunc(identifier, req)
+	}
+	if m.UpdateIncidentWithContextFunc != nil {
+		return m.UpdateIncidentWithContextFunc(context.Background(), identifier, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateIncidentWithContext calls UpdateIncidentWithContextFunc
+func (m *Mock) UpdateIncidentWithContext(ctx context.Context, identifier string, req *reamaze.UpdateIncidentRequest) (*reamaze.UpdateIncidentResponse, error) {
+	m.record("UpdateIncidentWithContext", identifier, req)
+	if m.UpdateIncidentWithContextFunc != nil {
+		return m.UpdateIncidentWithContextFunc(ctx, identifier, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetMessages calls GetMessagesFunc, or GetMessagesWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetMessages(o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error) {
+	m.record("GetMessages", o)
+	if m.GetMessagesFunc != nil {
+		return m.GetMessagesFunc(o...)
+	}
+	if m.GetMessagesWithContextFunc != nil {
+		return m.GetMessagesWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetMessagesWithContext calls GetMessagesWithContextFunc
+func (m *Mock) GetMessagesWithContext(ctx context.Context, o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error) {
+	m.record("GetMessagesWithContext", o)
+	if m.GetMessagesWithContextFunc != nil {
+		return m.GetMessagesWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetConversationMessages calls GetConversationMessagesFunc, or GetConversationMessagesWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetConversationMessages(slug string, o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error) {
+	m.record("GetConversationMessages", slug, o)
+	if m.GetConversationMessagesFunc != nil {
+		return m.GetConversationMessagesFunc(slug, o...)
+	}
+	if m.GetConversationMessagesWithContextFunc != nil {
+		return m.GetConversationMessagesWithContextFunc(context.Background(), slug, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetConversationMessagesWithContext calls GetConversationMessagesWithContextFunc
+func (m *Mock) GetConversationMessagesWithContext(ctx context.Context, slug string, o ...reamaze.MessagesOption) (*reamaze.GetMessagesResponse, error) {
+	m.record("GetConversationMessagesWithContext", slug, o)
+	if m.GetConversationMessagesWithContextFunc != nil {
+		return m.GetConversationMessagesWithContextFunc(ctx, slug, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateMessage calls CreateMessageFunc, or CreateMessageWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateMessage(slug string, req *reamaze.CreateMessageRequest) (*reamaze.CreateMessageResponse, error) {
+	m.record("CreateMessage", slug, req)
+	if m.CreateMessageFunc != nil {
+		return m.CreateMessageFunc(slug, req)
+	}
+	if m.CreateMessageWithContextFunc != nil {
+		return m.CreateMessageWithContextFunc(context.Background(), slug, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateMessageWithContext calls CreateMessageWithContextFunc
+func (m *Mock) CreateMessageWithContext(ctx context.Context, slug string, req *reamaze.CreateMessageRequest) (*reamaze.CreateMessageResponse, error) {
+	m.record("CreateMessageWithContext", slug, req)
+	if m.CreateMessageWithContextFunc != nil {
+		return m.CreateMessageWithContextFunc(ctx, slug, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// ReplyToConversation calls ReplyToConversationFunc, or ReplyToConversationWithContextFunc with context.Background() when it's nil
+func (m *Mock) ReplyToConversation(slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error) {
+	m.record("ReplyToConversation", slug, body, o)
+	if m.ReplyToConversationFunc != nil {
+		return m.ReplyToConversationFunc(slug, body, o...)
+	}
+	if m.ReplyToConversationWithContextFunc != nil {
+		return m.ReplyToConversationWithContextFunc(context.Background(), slug, body, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// ReplyToConversationWithContext calls ReplyToConversationWithContextFunc
+func (m *Mock) ReplyToConversationWithContext(ctx context.Context, slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error) {
+	m.record("ReplyToConversationWithContext", slug, body, o)
+	if m.ReplyToConversationWithContextFunc != nil {
+		return m.ReplyToConversationWithContextFunc(ctx, slug, body, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// AddInternalNote calls AddInternalNoteFunc, or AddInternalNoteWithContextFunc with context.Background() when it's nil
+func (m *Mock) AddInternalNote(slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error) {
+	m.record("AddInternalNote", slug, body, o)
+	if m.AddInternalNoteFunc != nil {
+		return m.AddInternalNoteFunc(slug, body, o...)
+	}
+	if m.AddInternalNoteWithContextFunc != nil {
+		return m.AddInternalNoteWithContextFunc(context.Background(), slug, body, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// AddInternalNoteWithContext calls AddInternalNoteWithContextFunc
+func (m *Mock) AddInternalNoteWithContext(ctx context.Context, slug string, body string, o ...reamaze.ReplyOption) (*reamaze.CreateMessageResponse, error) {
+	m.record("AddInternalNoteWithContext", slug, body, o)
+	if m.AddInternalNoteWithContextFunc != nil {
+		return m.AddInternalNoteWithContextFunc(ctx, slug, body, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetNotes calls GetNotesFunc, or GetNotesWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetNotes(identifier string) (*reamaze.GetNotesResponse, error) {
+	m.record("GetNotes", identifier)
+	if m.GetNotesFunc != nil {
+		return m.GetNotesFunc(identifier)
+	}
+	if m.GetNotesWithContextFunc != nil {
+		return m.GetNotesWithContextFunc(context.Background(), identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetNotesWithContext calls GetNotesWithContextFunc
+func (m *Mock) GetNotesWithContext(ctx context.Context, identifier string) (*reamaze.GetNotesResponse, error) {
+	m.record("GetNotesWithContext", identifier)
+	if m.GetNotesWithContextFunc != nil {
+		return m.GetNotesWithContextFunc(ctx, identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateNote calls CreateNoteFunc, or CreateNoteWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateNote(identifier string, req *reamaze.CreateNoteRequest) (*reamaze.CreateNoteResponse, error) {
+	m.record("CreateNote", identifier, req)
+	if m.CreateNoteFunc != nil {
+		return m.CreateNoteFunc(identifier, req)
+	}
+	if m.CreateNoteWithContextFunc != nil {
+		return m.CreateNoteWithContextFunc(context.Background(), identifier, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateNoteWithContext calls CreateNoteWithContextFunc
+func (m *Mock) CreateNoteWithContext(ctx context.Context, identifier string, req *reamaze.CreateNoteRequest) (*reamaze.CreateNoteResponse, error) {
+	m.record("CreateNoteWithContext", identifier, req)
+	if m.CreateNoteWithContextFunc != nil {
+		return m.CreateNoteWithContextFunc(ctx, identifier, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateNote calls UpdateNoteFunc, or UpdateNoteWithContextFunc with context.Background() when it's nil
+func (m *Mock) UpdateNote(identifier string, noteID string, req *reamaze.UpdateNoteRequest) (*reamaze.UpdateNoteResponse, error) {
+	m.record("UpdateNote", identifier, noteID, req)
+	if m.UpdateNoteFunc != nil {
+		return m.UpdateNoteFunc(identifier, noteID, req)
+	}
+	if m.UpdateNoteWithContextFunc != nil {
+		return m.UpdateNoteWithContextFunc(context.Background(), identifier, noteID, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateNoteWithContext calls UpdateNoteWithContextFunc
+func (m *Mock) UpdateNoteWithContext(ctx context.Context, identifier string, noteID string, req *reamaze.UpdateNoteRequest) (*reamaze.UpdateNoteResponse, error) {
+	m.record("UpdateNoteWithContext", identifier, noteID, req)
+	if m.UpdateNoteWithContextFunc != nil {
+		return m.UpdateNoteWithContextFunc(ctx, identifier, noteID, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// DeleteNote calls DeleteNoteFunc, or DeleteNoteWithContextFunc with context.Background() when it's nil
+func (m *Mock) DeleteNote(identifier string, noteID string) (*reamaze.DeleteNoteResponse, error) {
+	m.record("DeleteNote", identifier, noteID)
+	if m.DeleteNoteFunc != nil {
+		return m.DeleteNoteFunc(identifier, noteID)
+	}
+	if m.DeleteNoteWithContextFunc != nil {
+		return m.DeleteNoteWithContextFunc(context.Background(), identifier, noteID)
+	}
+	return nil, ErrNotMocked
+}
+
+// DeleteNoteWithContext calls DeleteNoteWithContextFunc
+func (m *Mock) DeleteNoteWithContext(ctx context.Context, identifier string, noteID string) (*reamaze.DeleteNoteResponse, error) {
+	m.record("DeleteNoteWithContext", identifier, noteID)
+	if m.DeleteNoteWithContextFunc != nil {
+		return m.DeleteNoteWithContextFunc(ctx, identifier, noteID)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsVolume calls GetReportsVolumeFunc, or GetReportsVolumeWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetReportsVolume(o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error) {
+	m.record("GetReportsVolume", o)
+	if m.GetReportsVolumeFunc != nil {
+		return m.GetReportsVolumeFunc(o...)
+	}
+	if m.GetReportsVolumeWithContextFunc != nil {
+		return m.GetReportsVolumeWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsVolumeWithContext calls GetReportsVolumeWithContextFunc
+func (m *Mock) GetReportsVolumeWithContext(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error) {
+	m.record("GetReportsVolumeWithContext", o)
+	if m.GetReportsVolumeWithContextFunc != nil {
+		return m.GetReportsVolumeWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsResponseTime calls GetReportsResponseTimeFunc, or GetReportsResponseTimeWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetReportsResponseTime(o ...reamaze.ReportsOption) (*reamaze.GetReportsResponseTimeRespone, error) {
+	m.record("GetReportsResponseTime", o)
+	if m.GetReportsResponseTimeFunc != nil {
+		return m.GetReportsResponseTimeFunc(o...)
+	}
+	if m.GetReportsResponseTimeWithContextFunc != nil {
+		return m.GetReportsResponseTimeWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsResponseTimeWithContext calls GetReportsResponseTimeWithContextFunc
+func (m *Mock) GetReportsResponseTimeWithContext(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsResponseTimeRespone, error) {
+	m.record("GetReportsResponseTimeWithContext", o)
+	if m.GetReportsResponseTimeWithContextFunc != nil {
+		return m.GetReportsResponseTimeWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsStaff calls GetReportsStaffFunc, or GetReportsStaffWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetReportsStaff(o ...reamaze.ReportsOption) (*reamaze.GetReportsStaffResponse, error) {
+	m.record("GetReportsStaff", o)
+	if m.GetReportsStaffFunc != nil {
+		return m.GetReportsStaffFunc(o...)
+	}
+	if m.GetReportsStaffWithContextFunc != nil {
+		return m.GetReportsStaffWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsStaffWithContext calls GetReportsStaffWithContextFunc
+func (m *Mock) GetReportsStaffWithContext(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsStaffResponse, error) {
+	m.record("GetReportsStaffWithContext", o)
+	if m.GetReportsStaffWithContextFunc != nil {
+		return m.GetReportsStaffWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsTags calls GetReportsTagsFunc, or GetReportsTagsWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetReportsTags(o ...reamaze.ReportsOption) (*reamaze.GetReportsTagsResponse, error) {
+	m.record("GetReportsTags", o)
+	if m.GetReportsTagsFunc != nil {
+		return m.GetReportsTagsFunc(o...)
+	}
+	if m.GetReportsTagsWithContextFunc != nil {
+		return m.GetReportsTagsWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsTagsWithContext calls GetReportsTagsWithContextFunc
+func (m *Mock) GetReportsTagsWithContext(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsTagsResponse, error) {
+	m.record("GetReportsTagsWithContext", o)
+	if m.GetReportsTagsWithContextFunc != nil {
+		return m.GetReportsTagsWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsChannelSummary calls GetReportsChannelSummaryFunc, or GetReportsChannelSummaryWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetReportsChannelSummary(o ...reamaze.ReportsOption) (*reamaze.GetReportsChannelSummaryResponse, error) {
+	m.record("GetReportsChannelSummary", o)
+	if m.GetReportsChannelSummaryFunc != nil {
+		return m.GetReportsChannelSummaryFunc(o...)
+	}
+	if m.GetReportsChannelSummaryWithContextFunc != nil {
+		return m.GetReportsChannelSummaryWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetReportsChannelSummaryWithContext calls GetReportsChannelSummaryWithContextFunc
+func (m *Mock) GetReportsChannelSummaryWithContext(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsChannelSummaryResponse, error) {
+	m.record("GetReportsChannelSummaryWithContext", o)
+	if m.GetReportsChannelSummaryWithContextFunc != nil {
+		return m.GetReportsChannelSummaryWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetResponseTemplates calls GetResponseTemplatesFunc, or GetResponseTemplatesWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetResponseTemplates() (*reamaze.GetResponseTemplatesResponse, error) {
+	m.record("GetResponseTemplates")
+	if m.GetResponseTemplatesFunc != nil {
+		return m.GetResponseTemplatesFunc()
+	}
+	if m.GetResponseTemplatesWithContextFunc != nil {
+		return m.GetResponseTemplatesWithContextFunc(context.Background())
+	}
+	return nil, ErrNotMocked
+}
+
+// GetResponseTemplatesWithContext calls GetResponseTemplatesWithContextFunc
+func (m *Mock) GetResponseTemplatesWithContext(ctx context.Context) (*reamaze.GetResponseTemplatesResponse, error) {
+	m.record("GetResponseTemplatesWithContext")
+	if m.GetResponseTemplatesWithContextFunc != nil {
+		return m.GetResponseTemplatesWithContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetResponseTemplate calls GetResponseTemplateFunc, or GetResponseTemplateWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetResponseTemplate(identifier string) (*reamaze.GetResponseTemplateResponse, error) {
+	m.record("GetResponseTemplate", identifier)
+	if m.GetResponseTemplateFunc != nil {
+		return m.GetResponseTemplateFunc(identifier)
+	}
+	if m.GetResponseTemplateWithContextFunc != nil {
+		return m.GetResponseTemplateWithContextFunc(context.Background(), identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetResponseTemplateWithContext calls GetResponseTemplateWithContextFunc
+func (m *Mock) GetResponseTemplateWithContext(ctx context.Context, identifier string) (*reamaze.GetResponseTemplateResponse, error) {
+	m.record("GetResponseTemplateWithContext", identifier)
+	if m.GetResponseTemplateWithContextFunc != nil {
+		return m.GetResponseTemplateWithContextFunc(ctx, identifier)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateResponseTemplate calls CreateResponseTemplateFunc, or CreateResponseTemplateWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateResponseTemplate(req *reamaze.CreateResponseTemplateRequest) (*reamaze.CreateResponseTemplateResponse, error) {
+	m.record("CreateResponseTemplate", req)
+	if m.CreateResponseTemplateFunc != nil {
+		return m.CreateResponseTemplateFunc(req)
+	}
+	if m.CreateResponseTemplateWithContextFunc != nil {
+		return m.CreateResponseTemplateWithContextFunc(context.Background(), req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateResponseTemplateWithContext calls CreateResponseTemplateWithContextFunc
+func (m *Mock) CreateResponseTemplateWithContext(ctx context.Context, req *reamaze.CreateResponseTemplateRequest) (*reamaze.CreateResponseTemplateResponse, error) {
+	m.record("CreateResponseTemplateWithContext", req)
+	if m.CreateResponseTemplateWithContextFunc != nil {
+		return m.CreateResponseTemplateWithContextFunc(ctx, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateResponseTemplate calls UpdateResponseTemplateFunc, or UpdateResponseTemplateWithContextFunc with context.Background() when it's nil
+func (m *Mock) UpdateResponseTemplate(identifier string, req *reamaze.UpdateResponseTemplateRequest) (*reamaze.UpdateResponseTemplateResponse, error) {
+	m.record("UpdateResponseTemplate", identifier, req)
+	if m.UpdateResponseTemplateFunc != nil {
+		return m.UpdateResponseTemplateFunc(identifier, req)
+	}
+	if m.UpdateResponseTemplateWithContextFunc != nil {
+		return m.UpdateResponseTemplateWithContextFunc(context.Background(), identifier, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// UpdateResponseTemplateWithContext calls UpdateResponseTemplateWithContextFunc
+func (m *Mock) UpdateResponseTemplateWithContext(ctx context.Context, identifier string, req *reamaze.UpdateResponseTemplateRequest) (*reamaze.UpdateResponseTemplateResponse, error) {
+	m.record("UpdateResponseTemplateWithContext", identifier, req)
+	if m.UpdateResponseTemplateWithContextFunc != nil {
+		return m.UpdateResponseTemplateWithContextFunc(ctx, identifier, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetStaff calls GetStaffFunc, or GetStaffWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetStaff(o ...reamaze.StaffOption) (*reamaze.GetStaffResponse, error) {
+	m.record("GetStaff", o)
+	if m.GetStaffFunc != nil {
+		return m.GetStaffFunc(o...)
+	}
+	if m.GetStaffWithContextFunc != nil {
+		return m.GetStaffWithContextFunc(context.Background(), o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetStaffWithContext calls GetStaffWithContextFunc
+func (m *Mock) GetStaffWithContext(ctx context.Context, o ...reamaze.StaffOption) (*reamaze.GetStaffResponse, error) {
+	m.record("GetStaffWithContext", o)
+	if m.GetStaffWithContextFunc != nil {
+		return m.GetStaffWithContextFunc(ctx, o...)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateStaff calls CreateStaffFunc, or CreateStaffWithContextFunc with context.Background() when it's nil
+func (m *Mock) CreateStaff(req *reamaze.CreateStaffRequest) (*reamaze.CreateStaffResponse, error) {
+	m.record("CreateStaff", req)
+	if m.CreateStaffFunc != nil {
+		return m.CreateStaffFunc(req)
+	}
+	if m.CreateStaffWithContextFunc != nil {
+		return m.CreateStaffWithContextFunc(context.Background(), req)
+	}
+	return nil, ErrNotMocked
+}
+
+// CreateStaffWithContext calls CreateStaffWithContextFunc
+func (m *Mock) CreateStaffWithContext(ctx context.Context, req *reamaze.CreateStaffRequest) (*reamaze.CreateStaffResponse, error) {
+	m.record("CreateStaffWithContext", req)
+	if m.CreateStaffWithContextFunc != nil {
+		return m.CreateStaffWithContextFunc(ctx, req)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetSystems calls GetSystemsFunc, or GetSystemsWithContextFunc with context.Background() when it's nil
+func (m *Mock) GetSystems() (*reamaze.GetSystemsResponse, error) {
+	m.record("GetSystems")
+	if m.GetSystemsFunc != nil {
+		return m.GetSystemsFunc()
+	}
+	if m.GetSystemsWithContextFunc != nil {
+		return m.GetSystemsWithContextFunc(context.Background())
+	}
+	return nil, ErrNotMocked
+}
+
+// GetSystemsWithContext calls GetSystemsWithContextFunc
+func (m *Mock) GetSystemsWithContext(ctx context.Context) (*reamaze.GetSystemsResponse, error) {
+	m.record("GetSystemsWithContext")
+	if m.GetSystemsWithContextFunc != nil {
+		return m.GetSystemsWithContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
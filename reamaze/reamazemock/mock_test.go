@@ -0,0 +1,78 @@
+package reamazemock
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+func TestMock(t *testing.T) {
+	var gotCtx context.Context
+	mock := &Mock{
+		GetConversationFunc: func(slug string) (*reamaze.GetConversationResponse, error) {
+			return &reamaze.GetConversationResponse{Slug: "plain-" + slug}, nil
+		},
+		GetConversationsWithContextFunc: func(ctx context.Context, o ...reamaze.ConversationsOption) (*reamaze.GetConversationsResponse, error) {
+			gotCtx = ctx
+			return &reamaze.GetConversationsResponse{TotalCount: len(o)}, nil
+		},
+	}
+	var api reamaze.API = mock
+
+	conversation, err := api.GetConversation("dummy")
+	if err != nil || conversation.Slug != "plain-dummy" {
+		t.Errorf("GetConversation() = %v, error = %v", conversation, err)
+	}
+	// GetConversations falls back to GetConversationsWithContextFunc
+	conversations, err := api.GetConversations(reamaze.WithPage(2), reamaze.WithCategory("support"))
+	if err != nil || conversations.TotalCount != 2 || gotCtx != context.Background() {
+		t.Errorf("GetConversations() = %v, error = %v", conversations, err)
+	}
+	// GetConversationWithContext doesn't fall back to GetConversationFunc
+	if _, err := api.GetConversationWithContext(context.Background(), "dummy"); !errors.Is(err, ErrNotMocked) {
+		t.Errorf("GetConversationWithContext() error = %v, want ErrNotMocked", err)
+	}
+	if _, err := api.GetSystems(); !errors.Is(err, ErrNotMocked) {
+		t.Errorf("GetSystems() error = %v, want ErrNotMocked", err)
+	}
+
+	want := []Call{
+		{Method: "GetConversation", Args: []any{"dummy"}},
+		{Method: "GetConversations", Args: []any{[]reamaze.ConversationsOption{reamaze.WithPage(2), reamaze.WithCategory("support")}}},
+		{Method: "GetConversationWithContext", Args: []any{"dummy"}},
+		{Method: "GetSystems", Args: nil},
+	}
+	if got := mock.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() = %v, want %v", got, want)
+	}
+}
+
+// loggingConversations shows decorating a single service, other methods are passed through to the embedded API
+type loggingConversations struct {
+	reamaze.API
+	logged []string
+}
+
+func (l *loggingConversations) GetConversation(slug string) (*reamaze.GetConversationResponse, error) {
+	l.logged = append(l.logged, slug)
+	return l.API.GetConversation(slug)
+}
+
+func TestMockDecorator(t *testing.T) {
+	mock := &Mock{
+		GetConversationWithContextFunc: func(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error) {
+			return &reamaze.GetConversationResponse{Slug: slug}, nil
+		},
+	}
+	var api reamaze.API = &loggingConversations{API: mock}
+	conversation, err := api.GetConversation("dummy")
+	if err != nil || conversation.Slug != "dummy" {
+		t.Errorf("GetConversation() = %v, error = %v", conversation, err)
+	}
+	if logged := api.(*loggingConversations).logged; len(logged) != 1 || logged[0] != "dummy" {
+		t.Errorf("logged = %v", logged)
+	}
+}
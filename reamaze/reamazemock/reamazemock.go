@@ -0,0 +1,49 @@
+// Package reamazemock provides Mock implementing reamaze.API for unit tests of code depending on reamaze.API
+// or one of the per-resource services.
+//
+//	mock := &reamazemock.Mock{
+//		GetConversationWithContextFunc: func(ctx context.Context, slug string) (*reamaze.GetConversationResponse, error) {
+//			return &reamaze.GetConversationResponse{Slug: slug}, nil
+//		},
+//	}
+//	var api reamaze.API = mock
+//
+// Mock is generated from the interfaces in api.go, run go generate in the reamaze package after changing them.
+package reamazemock
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// ErrNotMocked is returned by Mock methods without function field set
+var ErrNotMocked = errors.New("reamazemock: method not mocked")
+
+// Call is a single call recorded by Mock, Args don't include the context
+type Call struct {
+	Method string
+	Args   []any
+}
+
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns calls made so far in order, it's safe to use concurrently with the calls
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// making sure Mock implements API
+var _ reamaze.API = (*Mock)(nil)
@@ -0,0 +1,130 @@
+package reamazetest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// updateArticleRequest is the body of PUT /api/v1/articles/{slug}
+type updateArticleRequest struct {
+	Article struct {
+		Title   string                        `json:"title"`
+		Body    string                        `json:"body"`
+		Status  *reamaze.ReamazeArticleStatus `json:"status"`
+		TopicID string                        `json:"topic_id"`
+	} `json:"article"`
+}
+
+func (s *Server) handleArticles(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listArticles(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createArticle(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.getArticle(w, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateArticle(w, r, segments[0])
+	case len(segments) == 1:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findArticle(slug string) *reamaze.ReamazeArticle {
+	for _, a := range s.articles {
+		if a.Slug == slug {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) listArticles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	q := query.Get("q")
+	status, statusErr := strconv.Atoi(query.Get("status"))
+	matched := []reamaze.ReamazeArticle{}
+	for i := len(s.articles) - 1; i >= 0; i-- {
+		a := s.articles[i]
+		if len(q) > 0 && !containsFold(a.Title, q) && !containsFold(a.Body, q) {
+			continue
+		}
+		if statusErr == nil && a.Status != status {
+			continue
+		}
+		matched = append(matched, *a)
+	}
+	page, pageCount := paginate(r, matched, s.PageSize)
+	writeJSON(w, http.StatusOK, reamaze.GetArticlesResponse{
+		PageSize:   s.PageSize,
+		PageCount:  pageCount,
+		TotalCount: len(matched),
+		Articles:   page,
+	})
+}
+
+func (s *Server) getArticle(w http.ResponseWriter, slug string) {
+	a := s.findArticle(slug)
+	if a == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) createArticle(w http.ResponseWriter, r *http.Request) {
+	var req reamaze.CreateArticleRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Article.Title) == 0 {
+		writeValidationError(w, "title", "can't be blank")
+		return
+	}
+	now := s.now()
+	author, _ := s.staffUser("")
+	a := &reamaze.ReamazeArticle{
+		Title:     req.Article.Title,
+		Body:      req.Article.Body,
+		Slug:      slugify(req.Article.Title, "article") + "-" + strconv.Itoa(s.nextID()),
+		Status:    int(req.Article.Status),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Author:    author,
+	}
+	a.Topic.Slug = req.Article.TopicID
+	a.URL = s.URL + "/articles/" + a.Slug
+	a.EmbeddedURL = a.URL + "?embedded=true"
+	s.articles = append(s.articles, a)
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) updateArticle(w http.ResponseWriter, r *http.Request, slug string) {
+	a := s.findArticle(slug)
+	if a == nil {
+		writeNotFound(w)
+		return
+	}
+	var req updateArticleRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Article.Title) > 0 {
+		a.Title = req.Article.Title
+	}
+	if len(req.Article.Body) > 0 {
+		a.Body = req.Article.Body
+	}
+	if req.Article.Status != nil {
+		a.Status = int(*req.Article.Status)
+	}
+	if len(req.Article.TopicID) > 0 {
+		a.Topic.Slug = req.Article.TopicID
+	}
+	a.UpdatedAt = s.now()
+	writeJSON(w, http.StatusOK, a)
+}
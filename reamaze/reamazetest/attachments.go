@@ -0,0 +1,82 @@
+package reamazetest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// attachmentsPrefix is the path attachments are served from, without authentication like signed storage URLs
+const attachmentsPrefix = "/attachments/"
+
+type attachment struct {
+	id          int
+	name        string
+	contentType string
+	data        []byte
+}
+
+// parseDataURI decodes base64 data URI sent as attachment e.g. data:image/png;name=logo.png;base64,iVBORw0KGgo=
+func parseDataURI(uri string) (name string, contentType string, data []byte, ok bool) {
+	header, encoded, found := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !found || !strings.HasPrefix(uri, "data:") || !strings.HasSuffix(header, ";base64") {
+		return "", "", nil, false
+	}
+	params := strings.Split(strings.TrimSuffix(header, ";base64"), ";")
+	contentType = params[0]
+	for _, param := range params[1:] {
+		if value, found := strings.CutPrefix(param, "name="); found {
+			name, _ = url.PathUnescape(value)
+		}
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", nil, false
+	}
+	return name, contentType, data, true
+}
+
+// addAttachment stores attachment sent as data URI and returns it as listed in messages,
+// other values are listed as URLs as they are
+func (s *Server) addAttachment(value string) reamaze.Attachment {
+	name, contentType, data, ok := parseDataURI(value)
+	if !ok {
+		return reamaze.Attachment{URL: value}
+	}
+	a := &attachment{id: s.nextID(), name: name, contentType: contentType, data: data}
+	if len(a.name) == 0 {
+		a.name = "attachment"
+	}
+	s.attachments = append(s.attachments, a)
+	return reamaze.Attachment{
+		URL:             s.URL + attachmentsPrefix + strconv.Itoa(a.id) + "/" + url.PathEscape(a.name),
+		Image:           strings.HasPrefix(contentType, "image/"),
+		FileContentType: contentType,
+		FileFileName:    a.name,
+		FileFileSize:    len(data),
+	}
+}
+
+// serveAttachment serves files of attachments at /attachments/{id}/{name}
+func (s *Server) serveAttachment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w)
+		return
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, attachmentsPrefix), "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range s.attachments {
+		if strconv.Itoa(a.id) == id {
+			w.Header().Set("Content-Type", a.contentType)
+			w.Header().Set("Content-Length", strconv.Itoa(len(a.data)))
+			_, _ = w.Write(a.data)
+			return
+		}
+	}
+	writeNotFound(w)
+}
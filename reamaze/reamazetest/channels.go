@@ -0,0 +1,48 @@
+package reamazetest
+
+import (
+	"net/http"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// AddChannel adds channel to the Server and returns it as stored, conversations can be created only in existing channels.
+// Slug is derived from Name when empty.
+func (s *Server) AddChannel(channel reamaze.GetChannelResponse) reamaze.GetChannelResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if len(channel.Slug) == 0 {
+		channel.Slug = slugify(channel.Name, "channel")
+	}
+	if channel.CreatedAt.IsZero() {
+		channel.CreatedAt = now
+	}
+	channel.UpdatedAt = now
+	stored := &channel
+	s.channels = append(s.channels, stored)
+	return *stored
+}
+
+func (s *Server) handleChannels(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		channels := make([]reamaze.GetChannelResponse, 0, len(s.channels))
+		for _, ch := range s.channels {
+			channels = append(channels, *ch)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"total_count": len(channels), "channels": channels})
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		for _, ch := range s.channels {
+			if ch.Slug == segments[0] {
+				writeJSON(w, http.StatusOK, ch)
+				return
+			}
+		}
+		writeNotFound(w)
+	case len(segments) <= 1:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
@@ -0,0 +1,360 @@
+package reamazetest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+type identity struct {
+	Type       reamaze.ReamazeIdentifier `json:"type"`
+	Identifier string                    `json:"identifier"`
+}
+
+type contact struct {
+	reamaze.Contact
+	identities []identity
+}
+
+func (s *Server) handleContacts(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listContacts(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createContact(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.getContact(w, r, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateContact(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "identities" && r.Method == http.MethodGet:
+		s.getIdentities(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "identities" && r.Method == http.MethodPost:
+		s.createIdentity(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "notes" && r.Method == http.MethodGet:
+		s.listNotes(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "notes" && r.Method == http.MethodPost:
+		s.createNote(w, r, segments[0])
+	case len(segments) == 3 && segments[1] == "notes" && r.Method == http.MethodPut:
+		s.updateNote(w, r, segments[0], segments[2])
+	case len(segments) == 3 && segments[1] == "notes" && r.Method == http.MethodDelete:
+		s.deleteNote(w, r, segments[0], segments[2])
+	case len(segments) <= 3:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+// findContact returns contact having identity of given type, email is used when identifier_type query parameter is not set
+func (s *Server) findContact(r *http.Request, identifier string) *contact {
+	idType := reamaze.ReamazeIdentifier(r.URL.Query().Get("identifier_type"))
+	if len(idType) == 0 {
+		idType = reamaze.ReamazeIdentifierEmail
+	}
+	return s.findContactByIdentity(idType, identifier)
+}
+
+func (s *Server) findContactByIdentity(idType reamaze.ReamazeIdentifier, identifier string) *contact {
+	for _, c := range s.contacts {
+		for _, id := range c.identities {
+			if id.Type == idType && strings.EqualFold(id.Identifier, identifier) {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// findOrCreateContact returns contact with the user's email, creating it when needed
+func (s *Server) findOrCreateContact(user reamaze.User) *contact {
+	if c := s.findContactByIdentity(reamaze.ReamazeIdentifierEmail, user.Email); c != nil {
+		return c
+	}
+	return s.addContact(reamaze.Contact{Name: user.Name, Email: user.Email, Mobile: user.Mobile, Data: user.Data})
+}
+
+func (s *Server) addContact(c reamaze.Contact) *contact {
+	now := s.now()
+	c.ID0 = s.nextID()
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	if c.Notes == nil {
+		c.Notes = []reamaze.Note{}
+	}
+	stored := &contact{Contact: c}
+	if len(c.Email) > 0 {
+		stored.identities = append(stored.identities, identity{Type: reamaze.ReamazeIdentifierEmail, Identifier: c.Email})
+	}
+	if len(c.Mobile) > 0 {
+		stored.identities = append(stored.identities, identity{Type: reamaze.ReamazeIdentifierMobile, Identifier: c.Mobile})
+	}
+	s.contacts = append(s.contacts, stored)
+	return stored
+}
+
+// hasIdentityType checks if contact has identity of given type
+func (c *contact) hasIdentityType(idType reamaze.ReamazeIdentifier) bool {
+	for _, id := range c.identities {
+		if id.Type == idType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listContacts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	q := query.Get("q")
+	idType := reamaze.ReamazeIdentifier(query.Get("type"))
+	matched := []reamaze.Contact{}
+	for i := len(s.contacts) - 1; i >= 0; i-- {
+		c := s.contacts[i]
+		if len(q) > 0 && !containsFold(c.Name, q) && !containsFold(c.Email, q) && !containsFold(c.Mobile, q) && !containsFold(c.FriendlyName, q) {
+			continue
+		}
+		if len(idType) > 0 && !c.hasIdentityType(idType) {
+			continue
+		}
+		matched = append(matched, c.Contact)
+	}
+	page, pageCount := paginate(r, matched, s.PageSize)
+	writeJSON(w, http.StatusOK, reamaze.GetContactsResponse{
+		PageSize:   s.PageSize,
+		PageCount:  pageCount,
+		TotalCount: len(matched),
+		Contacts:   page,
+	})
+}
+
+func (s *Server) getContact(w http.ResponseWriter, r *http.Request, identifier string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, c.Contact)
+}
+
+func (s *Server) createContact(w http.ResponseWriter, r *http.Request) {
+	var req reamaze.CreateContactRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	c := req.Contact
+	if len(c.Email) == 0 && len(c.Mobile) == 0 {
+		writeValidationError(w, "email", "can't be blank")
+		return
+	}
+	if len(c.Email) > 0 && s.findContactByIdentity(reamaze.ReamazeIdentifierEmail, c.Email) != nil {
+		writeValidationError(w, "email", "has already been taken")
+		return
+	}
+	if len(c.Mobile) > 0 && s.findContactByIdentity(reamaze.ReamazeIdentifierMobile, string(c.Mobile)) != nil {
+		writeValidationError(w, "mobile", "has already been taken")
+		return
+	}
+	stored := s.addContact(reamaze.Contact{
+		Name:         c.Name,
+		Data:         c.Data,
+		Email:        c.Email,
+		Mobile:       string(c.Mobile),
+		FriendlyName: c.FriendlyName,
+		ID:           c.ID,
+	})
+	for _, body := range c.Notes {
+		s.addNote(stored, reamaze.CreateNoteRequest{Body: body})
+	}
+	writeJSON(w, http.StatusCreated, stored.Contact)
+}
+
+func (s *Server) updateContact(w http.ResponseWriter, r *http.Request, identifier string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.UpdateContactRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	// fields are sent even when empty so only the set ones are updated
+	if len(req.Contact.Name) > 0 {
+		c.Name = req.Contact.Name
+	}
+	if len(req.Contact.FriendlyName) > 0 {
+		c.FriendlyName = req.Contact.FriendlyName
+	}
+	if req.Contact.Data != nil {
+		c.Data = req.Contact.Data
+	}
+	for _, body := range req.Contact.Notes {
+		s.addNote(c, reamaze.CreateNoteRequest{Body: body})
+	}
+	c.UpdatedAt = s.now()
+	writeJSON(w, http.StatusOK, c.Contact)
+}
+
+func (s *Server) getIdentities(w http.ResponseWriter, r *http.Request, identifier string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]identity{"identities": append([]identity{}, c.identities...)})
+}
+
+func (s *Server) createIdentity(w http.ResponseWriter, r *http.Request, identifier string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.CreateContactIdentitiesRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	id := identity{Type: req.Identity.Type, Identifier: req.Identity.Identifier}
+	if len(id.Type) == 0 {
+		writeValidationError(w, "type", "can't be blank")
+		return
+	}
+	if len(id.Identifier) == 0 {
+		writeValidationError(w, "identifier", "can't be blank")
+		return
+	}
+	if s.findContactByIdentity(id.Type, id.Identifier) != nil {
+		writeValidationError(w, "identifier", "has already been taken")
+		return
+	}
+	c.identities = append(c.identities, id)
+	// the first identity of the type becomes the contact's attribute
+	switch id.Type {
+	case reamaze.ReamazeIdentifierEmail:
+		setIfEmpty(&c.Email, id.Identifier)
+	case reamaze.ReamazeIdentifierMobile:
+		setIfEmpty(&c.Mobile, id.Identifier)
+	case reamaze.ReamazeIdentifierTwitter:
+		setIfEmpty(&c.Twitter, id.Identifier)
+	case reamaze.ReamazeIdentifierFacebook:
+		setIfEmpty(&c.Facebook, id.Identifier)
+	case reamaze.ReamazeIdentifierInstagram:
+		setIfEmpty(&c.Instagram, id.Identifier)
+	}
+	c.UpdatedAt = s.now()
+	writeJSON(w, http.StatusCreated, map[string][]identity{"identities": append([]identity{}, c.identities...)})
+}
+
+func setIfEmpty(field *string, value string) {
+	if len(*field) == 0 {
+		*field = value
+	}
+}
+
+// addNote adds note to the contact, the API user is the creator unless CreatorEmail is set
+func (s *Server) addNote(c *contact, req reamaze.CreateNoteRequest) reamaze.Note {
+	now := s.now()
+	creator, _ := s.staffUser(req.CreatorEmail)
+	note := reamaze.Note{
+		ID:        strconv.Itoa(s.nextID()),
+		Note:      req.Body,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Creator:   creator,
+	}
+	if !req.CreatedAt.IsZero() {
+		note.CreatedAt = req.CreatedAt.UTC()
+	}
+	c.Notes = append(c.Notes, note)
+	return note
+}
+
+func (s *Server) listNotes(w http.ResponseWriter, r *http.Request, identifier string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, reamaze.GetNotesResponse(append([]reamaze.Note{}, c.Notes...)))
+}
+
+func (s *Server) createNote(w http.ResponseWriter, r *http.Request, identifier string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.CreateNoteRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Body) == 0 {
+		writeValidationError(w, "body", "can't be blank")
+		return
+	}
+	if len(req.CreatorEmail) > 0 && s.findStaff(req.CreatorEmail) == nil {
+		writeValidationError(w, "creator_email", "is not a staff user")
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.addNote(c, req))
+}
+
+// findNote returns index of the note in contact's notes or -1
+func (c *contact) findNote(noteID string) int {
+	for i, note := range c.Notes {
+		if note.ID == noteID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) updateNote(w http.ResponseWriter, r *http.Request, identifier string, noteID string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	i := c.findNote(noteID)
+	if i < 0 {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.UpdateNoteRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.CreatorEmail) > 0 {
+		creator, ok := s.staffUser(req.CreatorEmail)
+		if !ok {
+			writeValidationError(w, "creator_email", "is not a staff user")
+			return
+		}
+		c.Notes[i].Creator = creator
+	}
+	if len(req.Body) > 0 {
+		c.Notes[i].Note = req.Body
+	}
+	if !req.CreatedAt.IsZero() {
+		c.Notes[i].CreatedAt = req.CreatedAt.UTC()
+	}
+	c.Notes[i].UpdatedAt = s.now()
+	writeJSON(w, http.StatusOK, c.Notes[i])
+}
+
+func (s *Server) deleteNote(w http.ResponseWriter, r *http.Request, identifier string, noteID string) {
+	c := s.findContact(r, identifier)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	i := c.findNote(noteID)
+	if i < 0 {
+		writeNotFound(w)
+		return
+	}
+	note := c.Notes[i]
+	c.Notes = append(c.Notes[:i], c.Notes[i+1:]...)
+	writeJSON(w, http.StatusOK, note)
+}
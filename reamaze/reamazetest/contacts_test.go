@@ -0,0 +1,116 @@
+package reamazetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+func TestServer_Contacts(t *testing.T) {
+	_, client := newTestClient(t)
+	req := &reamaze.CreateContactRequest{}
+	req.Contact.Name = "John Doe"
+	req.Contact.Email = "john@example.com"
+	req.Contact.Mobile = "+48123456789"
+	req.Contact.Notes = []string{"VIP customer"}
+	created, err := client.CreateContact(req)
+	if err != nil {
+		t.Fatalf("CreateContact() error = %v", err)
+	}
+	if created.ID0 == 0 || len(created.Notes) != 1 || created.Notes[0].Creator.Email != Email {
+		t.Errorf("CreateContact() = %+v", created)
+	}
+	if _, err := client.CreateContact(req); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateContact() duplicate error = %v, want ErrValidation", err)
+	}
+	other := &reamaze.CreateContactRequest{}
+	other.Contact.Name = "Jane Roe"
+	other.Contact.Email = "jane@example.com"
+	if _, err := client.CreateContact(other); err != nil {
+		t.Fatalf("CreateContact() error = %v", err)
+	}
+
+	update := &reamaze.UpdateContactRequest{}
+	update.Contact.FriendlyName = "Johnny"
+	updated, err := client.UpdateContact("+48123456789", update, reamaze.ReamazeIdentifierMobile)
+	if err != nil || updated.FriendlyName != "Johnny" || updated.Name != "John Doe" {
+		t.Errorf("UpdateContact() = %+v, error = %v", updated, err)
+	}
+
+	identity := &reamaze.CreateContactIdentitiesRequest{}
+	identity.Identity.Type = reamaze.ReamazeIdentifierTwitter
+	identity.Identity.Identifier = "johndoe"
+	identities, err := client.CreateContactIdentities("john@example.com", identity)
+	if err != nil || len(identities.Identities) != 3 {
+		t.Errorf("CreateContactIdentities() = %+v, error = %v", identities, err)
+	}
+	if _, err := client.CreateContactIdentities("jane@example.com", identity); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateContactIdentities() taken identifier error = %v, want ErrValidation", err)
+	}
+	if got, err := client.GetContactIdentities("john@example.com"); err != nil || got.Identities[2].Identifier != "johndoe" {
+		t.Errorf("GetContactIdentities() = %+v, error = %v", got, err)
+	}
+
+	tests := []struct {
+		name string
+		opts []reamaze.ContactsOption
+		want []string
+	}{
+		{name: "Testing all contacts", opts: nil, want: []string{"jane@example.com", "john@example.com"}},
+		{name: "Testing search query", opts: []reamaze.ContactsOption{reamaze.WithContactsQuery("johnny")}, want: []string{"john@example.com"}},
+		{name: "Testing identity type", opts: []reamaze.ContactsOption{reamaze.WithContactsType(reamaze.ReamazeIdentifierTwitter)}, want: []string{"john@example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.GetContacts(tt.opts...)
+			if err != nil {
+				t.Fatalf("GetContacts() error = %v", err)
+			}
+			if resp.TotalCount != len(tt.want) {
+				t.Fatalf("GetContacts() total = %v, want %v", resp.TotalCount, len(tt.want))
+			}
+			for i, email := range tt.want {
+				if resp.Contacts[i].Email != email {
+					t.Errorf("GetContacts()[%d] = %v, want %v", i, resp.Contacts[i].Email, email)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_Notes(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddStaff(reamaze.StaffUser{Name: "Agent", Email: "agent@example.com"})
+	req := &reamaze.CreateContactRequest{}
+	req.Contact.Email = "john@example.com"
+	if _, err := client.CreateContact(req); err != nil {
+		t.Fatalf("CreateContact() error = %v", err)
+	}
+
+	created, err := client.CreateNote("john@example.com", &reamaze.CreateNoteRequest{Body: "Called about refund", CreatorEmail: "agent@example.com"})
+	if err != nil || len(created.ID) == 0 || created.Creator.Email != "agent@example.com" {
+		t.Fatalf("CreateNote() = %+v, error = %v", created, err)
+	}
+	if _, err := client.CreateNote("john@example.com", &reamaze.CreateNoteRequest{Body: "dummy", CreatorEmail: "john@example.com"}); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateNote() non staff creator error = %v, want ErrValidation", err)
+	}
+	updated, err := client.UpdateNote("john@example.com", created.ID, &reamaze.UpdateNoteRequest{Body: "Refund issued"})
+	if err != nil || updated.Note != "Refund issued" || updated.Creator.Email != "agent@example.com" {
+		t.Errorf("UpdateNote() = %+v, error = %v", updated, err)
+	}
+	notes, err := client.GetNotes("john@example.com")
+	if err != nil || len(*notes) != 1 || (*notes)[0].Note != "Refund issued" {
+		t.Errorf("GetNotes() = %+v, error = %v", notes, err)
+	}
+	deleted, err := client.DeleteNote("john@example.com", created.ID)
+	if err != nil || deleted.ID != created.ID {
+		t.Errorf("DeleteNote() = %+v, error = %v", deleted, err)
+	}
+	if _, err := client.DeleteNote("john@example.com", created.ID); !errors.Is(err, reamaze.ErrNotFound) {
+		t.Errorf("DeleteNote() deleted note error = %v, want ErrNotFound", err)
+	}
+	if notes, err := client.GetNotes("john@example.com"); err != nil || len(*notes) != 0 {
+		t.Errorf("GetNotes() after delete = %+v, error = %v", notes, err)
+	}
+}
@@ -0,0 +1,451 @@
+package reamazetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+type conversation struct {
+	reamaze.GetConversationResponse
+}
+
+type message struct {
+	reamaze.Message
+	conversation *conversation
+	originID     string
+}
+
+func (s *Server) handleConversations(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listConversations(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createConversation(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.getConversation(w, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateConversation(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "messages" && r.Method == http.MethodGet:
+		s.listConversationMessages(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "messages" && r.Method == http.MethodPost:
+		s.createMessage(w, r, segments[0])
+	case len(segments) <= 2:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleMessages(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.writeMessages(w, r, s.messages)
+	case len(segments) == 0:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findConversation(slug string) *conversation {
+	for _, c := range s.conversations {
+		if c.Slug == slug {
+			return c
+		}
+	}
+	return nil
+}
+
+// findCategory returns category of the channel with given slug
+func (s *Server) findCategory(slug string) (reamaze.Category, bool) {
+	for _, ch := range s.channels {
+		if ch.Slug == slug {
+			return reamaze.Category{Name: ch.Name, Slug: ch.Slug, Email: ch.Email, Channel: int(ch.Channel)}, true
+		}
+	}
+	return reamaze.Category{}, false
+}
+
+// channelOrigin returns origin of conversations created in the channel, codes of most channels are the same as origin codes
+func channelOrigin(channel reamaze.ReamazeChannelType) reamaze.ReamazeOrigin {
+	switch channel {
+	case reamaze.ReamazeChannelEmail, reamaze.ReamazeChannelTwitter, reamaze.ReamazeChannelFacebook, reamaze.ReamazeChannelInstagram,
+		reamaze.ReamazeChannelSMS, reamaze.ReamazeChannelWhatsApp:
+		return reamaze.ReamazeOrigin(channel)
+	case reamaze.ReamazeChannelChat:
+		return reamaze.ReamazeOriginChat
+	default:
+		return reamaze.ReamazeOriginAPI
+	}
+}
+
+// matchesFilter checks if conversation status matches the filter query parameter, spam is returned only when asked for explicitly
+func matchesFilter(c *conversation, filter string) bool {
+	status := c.Status
+	switch reamaze.ReamazeFilter(filter) {
+	case reamaze.ReamazeFilterOpen:
+		return status == reamaze.ReamazeStatusUnresolved || status == reamaze.ReamazeStatusPending
+	case reamaze.ReamazeFilterUnassigned:
+		return (status == reamaze.ReamazeStatusUnresolved || status == reamaze.ReamazeStatusPending) && c.Assignee == nil
+	case reamaze.ReamazeFilterArchived:
+		return status == reamaze.ReamazeStatusResolved || status == reamaze.ReamazeStatusArchived ||
+			status == reamaze.ReamazeStatusAutoResolved || status == reamaze.ReamazeStatusChatbotResolved
+	case "spam":
+		return status == reamaze.ReamazeStatusSpam
+	default:
+		return status != reamaze.ReamazeStatusSpam
+	}
+}
+
+func (s *Server) listConversations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	start, end := dateRange(r)
+	var tags []string
+	if len(query.Get("tag")) > 0 {
+		tags = strings.Split(query.Get("tag"), ",")
+	}
+	matched := []reamaze.GetConversationResponse{}
+	// newest conversations come first
+	for i := len(s.conversations) - 1; i >= 0; i-- {
+		c := s.conversations[i]
+		if !matchesFilter(c, query.Get("filter")) || !inRange(c.CreatedAt, start, end) {
+			continue
+		}
+		if len(query.Get("for")) > 0 && !strings.EqualFold(c.Author.Email, query.Get("for")) {
+			continue
+		}
+		if len(query.Get("category")) > 0 && c.Category.Slug != query.Get("category") {
+			continue
+		}
+		if !hasTags(c.TagList, tags) || !hasData(c.Data, query) {
+			continue
+		}
+		matched = append(matched, c.GetConversationResponse)
+	}
+	if sortBy := reamaze.ReamazeSort(query.Get("sort")); sortBy == reamaze.ReamazeSortUpdated || sortBy == reamaze.ReamazeSortChanged {
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].UpdatedAt.After(matched[j].UpdatedAt)
+		})
+	}
+	page, pageCount := paginate(r, matched, s.PageSize)
+	writeJSON(w, http.StatusOK, reamaze.GetConversationsResponse{
+		PageSize:      s.PageSize,
+		PageCount:     pageCount,
+		TotalCount:    len(matched),
+		Conversations: page,
+	})
+}
+
+// hasTags checks if tagList contains all of the tags
+func hasTags(tagList []string, tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, t := range tagList {
+			if strings.EqualFold(strings.TrimSpace(tag), t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hasData checks if conversation data matches all data[key]=value query parameters
+func hasData(data any, query map[string][]string) bool {
+	values, _ := data.(map[string]any)
+	for key, v := range query {
+		if !strings.HasPrefix(key, "data[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		field := strings.TrimSuffix(strings.TrimPrefix(key, "data["), "]")
+		value, ok := values[field].(string)
+		if !ok || len(v) == 0 || value != v[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) getConversation(w http.ResponseWriter, slug string) {
+	c := s.findConversation(slug)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, c.GetConversationResponse)
+}
+
+func (s *Server) createConversation(w http.ResponseWriter, r *http.Request) {
+	var req reamaze.CreateConversationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	c := req.Conversation
+	if len(c.Message.Body) == 0 {
+		writeValidationError(w, "message.body", "can't be blank")
+		return
+	}
+	if len(c.User.Email) == 0 {
+		writeValidationError(w, "user.email", "can't be blank")
+		return
+	}
+	category, ok := s.findCategory(c.Category)
+	if !ok {
+		writeValidationError(w, "category", "is invalid")
+		return
+	}
+	// re:amaze creates the contact for the conversation author when it doesn't exist yet
+	author := c.User
+	if staff, ok := s.staffUser(author.Email); ok {
+		author = staff
+	} else {
+		contact := s.findOrCreateContact(author)
+		author.ID = contact.ID0
+		if len(author.Name) == 0 {
+			author.Name = contact.Name
+		}
+	}
+	now := s.now()
+	conv := &conversation{reamaze.GetConversationResponse{
+		Subject:        c.Subject,
+		Slug:           slugify(c.Subject, "conversation") + "-" + strconv.Itoa(s.nextID()),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		Origin:         channelOrigin(reamaze.ReamazeChannelType(category.Channel)),
+		Data:           c.Data,
+		Author:         author,
+		TagList:        c.TagList,
+		DisplaySubject: c.Subject,
+		Category:       category,
+		Followers:      []reamaze.Follower{},
+	}}
+	if len(conv.DisplaySubject) == 0 {
+		conv.DisplaySubject = c.Message.Body
+	}
+	conv.PermaURL = s.URL + "/admin/conversations/" + conv.Slug
+	s.conversations = append(s.conversations, conv)
+	s.addMessage(conv, reamaze.MessageRequest{
+		Body:                c.Message.Body,
+		User:                &author,
+		SupressNotification: c.SupressNotification,
+		SupressAutoresolve:  true,
+		Attachment:          c.Message.Attachment,
+		Attachments:         c.Message.Attachments,
+	})
+	// the first message doesn't change the status asked for
+	conv.Status = reamaze.ReamazeStatusUnresolved
+	if c.Status != nil {
+		conv.Status = *c.Status
+	}
+	conv.Message = reamaze.MessageSummary{Body: c.Message.Body, CreatedAt: now}
+	writeJSON(w, http.StatusCreated, conv.GetConversationResponse)
+}
+
+func (s *Server) updateConversation(w http.ResponseWriter, r *http.Request, slug string) {
+	conv := s.findConversation(slug)
+	if conv == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.UpdateConversationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	c := req.Conversation
+	// validating everything first so that invalid request doesn't change the conversation partially
+	// empty assignee unassigns the conversation
+	var assignee any
+	if c.Assignee != nil && len(*c.Assignee) > 0 {
+		staff, ok := s.staffUser(*c.Assignee)
+		if !ok {
+			writeValidationError(w, "assignee", "is not a staff user")
+			return
+		}
+		assignee = staff
+	}
+	var category reamaze.Category
+	if c.Category != nil {
+		var ok bool
+		if category, ok = s.findCategory(*c.Category); !ok {
+			writeValidationError(w, "category", "is invalid")
+			return
+		}
+	}
+	if c.TagList != nil {
+		conv.TagList = *c.TagList
+	}
+	if c.Status != nil {
+		conv.Status = *c.Status
+		// hold ends when the status changes
+		if conv.Status != reamaze.ReamazeStatusOnHold {
+			conv.HoldUntil = nil
+		}
+	}
+	if c.HoldUntil != nil {
+		conv.HoldUntil = c.HoldUntil.UTC()
+	}
+	if c.Data != nil {
+		conv.Data = c.Data
+	}
+	if c.Assignee != nil {
+		conv.Assignee = assignee
+	}
+	if c.Category != nil {
+		conv.Category = category
+	}
+	conv.UpdatedAt = s.now()
+	writeJSON(w, http.StatusOK, conv.GetConversationResponse)
+}
+
+// addMessage appends message to the conversation and applies the status changes re:amaze makes:
+// customer message reopens the conversation, staff reply resolves it unless autoresolve is suppressed
+// and internal notes don't change the status at all
+func (s *Server) addMessage(conv *conversation, req reamaze.MessageRequest) *message {
+	now := s.now()
+	user, isStaff := s.staffUser("")
+	if req.User != nil && len(req.User.Email) > 0 {
+		user, isStaff = s.staffUser(req.User.Email)
+		if !isStaff {
+			user = *req.User
+		}
+	}
+	m := &message{
+		Message: reamaze.Message{
+			Visibility:       int(req.Visibility),
+			Origin:           conv.Origin,
+			CreatedAt:        now,
+			Attachments:      []reamaze.Attachment{},
+			Body:             req.Body,
+			DirectRecipients: []any{},
+			Recipients:       []any{},
+			User:             user,
+		},
+		conversation: conv,
+	}
+	for _, attachment := range append([]string{req.Attachment}, req.Attachments...) {
+		if len(attachment) > 0 {
+			m.Attachments = append(m.Attachments, s.addAttachment(attachment))
+		}
+	}
+	s.messages = append(s.messages, m)
+
+	status := conv.Status
+	switch {
+	case req.Visibility == reamaze.ReamazeVisibilityInternalNote:
+	case !isStaff:
+		conv.LastCustomerMessage = reamaze.MessageSummary{Body: req.Body, CreatedAt: now}
+		if status != reamaze.ReamazeStatusUnresolved && status != reamaze.ReamazeStatusSpam {
+			conv.Status = reamaze.ReamazeStatusUnresolved
+		}
+	case !req.SupressAutoresolve:
+		conv.Status = reamaze.ReamazeStatusResolved
+	}
+	conv.MessageCount++
+	conv.UpdatedAt = now
+	return m
+}
+
+// findMessage returns message of the conversation posted with given origin id or nil,
+// messages are deduplicated by origin id so that retried requests don't post them twice
+func (s *Server) findMessage(conv *conversation, originID string) *message {
+	if len(originID) == 0 {
+		return nil
+	}
+	for _, m := range s.messages {
+		if m.conversation == conv && m.originID == originID {
+			return m
+		}
+	}
+	return nil
+}
+
+func (s *Server) createMessage(w http.ResponseWriter, r *http.Request, slug string) {
+	conv := s.findConversation(slug)
+	if conv == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.CreateMessageRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Message.Body) == 0 {
+		writeValidationError(w, "body", "can't be blank")
+		return
+	}
+	status := http.StatusCreated
+	m := s.findMessage(conv, req.Message.OriginID)
+	if m == nil {
+		m = s.addMessage(conv, req.Message)
+		m.originID = req.Message.OriginID
+	} else {
+		status = http.StatusOK
+	}
+	writeJSON(w, status, reamaze.CreateMessageResponse{
+		Body:         m.Body,
+		Visibility:   m.Visibility,
+		CreatedAt:    m.CreatedAt.Format("2006-01-02T15:04:05.000Z07:00"),
+		OriginID:     req.Message.OriginID,
+		User:         m.User,
+		Conversation: summary(conv),
+	})
+}
+
+func (s *Server) listConversationMessages(w http.ResponseWriter, r *http.Request, slug string) {
+	conv := s.findConversation(slug)
+	if conv == nil {
+		writeNotFound(w)
+		return
+	}
+	var messages []*message
+	for _, m := range s.messages {
+		if m.conversation == conv {
+			messages = append(messages, m)
+		}
+	}
+	s.writeMessages(w, r, messages)
+}
+
+// writeMessages responds with messages matching the query parameters, newest first
+func (s *Server) writeMessages(w http.ResponseWriter, r *http.Request, messages []*message) {
+	query := r.URL.Query()
+	start, end := dateRange(r)
+	matched := []reamaze.Message{}
+	for i := len(messages) - 1; i >= 0; i-- {
+		m := messages[i]
+		if visibility := query.Get("visibility"); len(visibility) > 0 && visibility != strconv.Itoa(m.Visibility) {
+			continue
+		}
+		if !inRange(m.CreatedAt, start, end) {
+			continue
+		}
+		msg := m.Message
+		msg.Conversation = summary(m.conversation)
+		matched = append(matched, msg)
+	}
+	page, pageCount := paginate(r, matched, s.PageSize)
+	writeJSON(w, http.StatusOK, reamaze.GetMessagesResponse{
+		PageSize:   s.PageSize,
+		PageCount:  pageCount,
+		TotalCount: len(matched),
+		Messages:   page,
+	})
+}
+
+// summary returns short form of the conversation embedded in messages
+func summary(c *conversation) reamaze.MessageConversation {
+	return reamaze.MessageConversation{
+		Subject:   c.Subject,
+		Slug:      c.Slug,
+		CreatedAt: c.CreatedAt,
+		Category:  c.Category,
+		Followers: c.Followers,
+	}
+}
@@ -0,0 +1,278 @@
+package reamazetest
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// createConversation creates conversation in the support channel started by customer@example.com
+func createConversation(t *testing.T, client *reamaze.Client, subject string, tags ...string) *reamaze.CreateConversationResponse {
+	t.Helper()
+	req := &reamaze.CreateConversationRequest{}
+	req.Conversation.Subject = subject
+	req.Conversation.Category = "support"
+	req.Conversation.TagList = tags
+	req.Conversation.Message = reamaze.ConversationMessage{Body: "Hello"}
+	req.Conversation.User = reamaze.User{Name: "Customer", Email: "customer@example.com"}
+	resp, err := client.CreateConversation(req)
+	if err != nil {
+		t.Fatalf("CreateConversation() error = %v", err)
+	}
+	return resp
+}
+
+func TestServer_Conversations(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	srv.AddStaff(reamaze.StaffUser{Name: "Agent", Email: "agent@example.com"})
+
+	invalid := &reamaze.CreateConversationRequest{}
+	invalid.Conversation.Category = "unknown"
+	invalid.Conversation.Message.Body = "Hello"
+	invalid.Conversation.User.Email = "customer@example.com"
+	if _, err := client.CreateConversation(invalid); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateConversation() unknown category error = %v, want ErrValidation", err)
+	}
+
+	created := createConversation(t, client, "Order #1", "orders")
+	if created.Status != reamaze.ReamazeStatusUnresolved || created.Category.Slug != "support" || created.MessageCount != 1 ||
+		created.Author.Email != "customer@example.com" || created.Origin != reamaze.ReamazeOriginEmail {
+		t.Errorf("CreateConversation() = %+v", created)
+	}
+	// the customer becomes a contact
+	if contact, err := client.GetContact("customer@example.com"); err != nil || contact.Name != "Customer" {
+		t.Errorf("GetContact() = %+v, error = %v", contact, err)
+	}
+	second := createConversation(t, client, "Order #2")
+
+	update := &reamaze.UpdateConversationRequest{}
+	update.Conversation.Assignee = reamaze.Ptr("agent@example.com")
+	update.Conversation.TagList = reamaze.Ptr([]string{"orders", "vip"})
+	updated, err := client.UpdateConversation(created.Slug, update)
+	if err != nil || updated.TagList[1] != "vip" || updated.Assignee == nil {
+		t.Errorf("UpdateConversation() = %+v, error = %v", updated, err)
+	}
+	invalidUpdate := &reamaze.UpdateConversationRequest{}
+	invalidUpdate.Conversation.Assignee = reamaze.Ptr("customer@example.com")
+	if _, err := client.UpdateConversation(created.Slug, invalidUpdate); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("UpdateConversation() assignee error = %v, want ErrValidation", err)
+	}
+
+	tests := []struct {
+		name string
+		opts []reamaze.ConversationsOption
+		want []string
+	}{
+		{name: "Testing all conversations", opts: nil, want: []string{second.Slug, created.Slug}},
+		{name: "Testing tag filter", opts: []reamaze.ConversationsOption{reamaze.WithTags("vip")}, want: []string{created.Slug}},
+		{name: "Testing unassigned filter", opts: []reamaze.ConversationsOption{reamaze.WithFilter(reamaze.ReamazeFilterUnassigned)}, want: []string{second.Slug}},
+		{name: "Testing sort by updated", opts: []reamaze.ConversationsOption{reamaze.WithSort(reamaze.ReamazeSortUpdated)}, want: []string{created.Slug, second.Slug}},
+		{name: "Testing for filter", opts: []reamaze.ConversationsOption{reamaze.WithFor("other@example.com")}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.GetConversations(tt.opts...)
+			if err != nil {
+				t.Fatalf("GetConversations() error = %v", err)
+			}
+			if resp.TotalCount != len(tt.want) {
+				t.Fatalf("GetConversations() total = %v, want %v", resp.TotalCount, len(tt.want))
+			}
+			for i, slug := range tt.want {
+				if resp.Conversations[i].Slug != slug {
+					t.Errorf("GetConversations()[%d] = %v, want %v", i, resp.Conversations[i].Slug, slug)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_MessagesStatusTransitions(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	conversation := createConversation(t, client, "Refund")
+
+	steps := []struct {
+		name    string
+		message reamaze.MessageRequest
+		want    reamaze.ReamazeStatus
+	}{
+		{name: "Testing internal note", message: reamaze.MessageRequest{Body: "checking", Visibility: reamaze.ReamazeVisibilityInternalNote}, want: reamaze.ReamazeStatusUnresolved},
+		{name: "Testing staff reply with suppressed autoresolve", message: reamaze.MessageRequest{Body: "on it", SupressAutoresolve: true}, want: reamaze.ReamazeStatusUnresolved},
+		{name: "Testing staff reply", message: reamaze.MessageRequest{Body: "refunded"}, want: reamaze.ReamazeStatusResolved},
+		{name: "Testing customer reply", message: reamaze.MessageRequest{Body: "thanks", User: &reamaze.User{Email: "customer@example.com"}}, want: reamaze.ReamazeStatusUnresolved},
+	}
+	for _, tt := range steps {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.CreateMessage(conversation.Slug, &reamaze.CreateMessageRequest{Message: tt.message}); err != nil {
+				t.Fatalf("CreateMessage() error = %v", err)
+			}
+			got, err := client.GetConversation(conversation.Slug)
+			if err != nil {
+				t.Fatalf("GetConversation() error = %v", err)
+			}
+			if got.Status != tt.want {
+				t.Errorf("GetConversation() status = %v, want %v", got.Status, tt.want)
+			}
+		})
+	}
+
+	got, err := client.GetConversation(conversation.Slug)
+	if err != nil || got.MessageCount != 5 || got.LastCustomerMessage.Body != "thanks" {
+		t.Errorf("GetConversation() = %+v, error = %v", got, err)
+	}
+	thread, err := client.GetConversationMessages(conversation.Slug)
+	if err != nil || thread.TotalCount != 5 || thread.Messages[0].Body != "thanks" || thread.Messages[0].Conversation.Slug != conversation.Slug {
+		t.Errorf("GetConversationMessages() = %+v, error = %v", thread, err)
+	}
+	notes, err := client.GetMessages(reamaze.WithMessagesVisibility(reamaze.ReamazeVisibilityInternalNote))
+	if err != nil || notes.TotalCount != 1 || notes.Messages[0].Body != "checking" || !notes.Messages[0].User.Staff {
+		t.Errorf("GetMessages() = %+v, error = %v", notes, err)
+	}
+	if _, err := client.CreateMessage("unknown", &reamaze.CreateMessageRequest{Message: reamaze.MessageRequest{Body: "dummy"}}); !errors.Is(err, reamaze.ErrNotFound) {
+		t.Errorf("CreateMessage() unknown conversation error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestServer_PartialUpdate(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	conversation := createConversation(t, client, "Refund", "orders")
+
+	steps := []struct {
+		name       string
+		update     func(req *reamaze.UpdateConversationRequest)
+		wantStatus reamaze.ReamazeStatus
+		wantTags   []string
+		assigned   bool
+	}{
+		{
+			name: "Testing assignee and status",
+			update: func(req *reamaze.UpdateConversationRequest) {
+				req.Conversation.Assignee = reamaze.Ptr(Email)
+				req.Conversation.Status = reamaze.Ptr(reamaze.ReamazeStatusResolved)
+			},
+			wantStatus: reamaze.ReamazeStatusResolved, wantTags: []string{"orders"}, assigned: true,
+		},
+		{
+			name: "Testing reopening with zero status",
+			update: func(req *reamaze.UpdateConversationRequest) {
+				req.Conversation.Status = reamaze.Ptr(reamaze.ReamazeStatusUnresolved)
+			},
+			wantStatus: reamaze.ReamazeStatusUnresolved, wantTags: []string{"orders"}, assigned: true,
+		},
+		{
+			name:       "Testing removing all tags",
+			update:     func(req *reamaze.UpdateConversationRequest) { req.Conversation.TagList = reamaze.Ptr([]string{}) },
+			wantStatus: reamaze.ReamazeStatusUnresolved, wantTags: []string{}, assigned: true,
+		},
+		{
+			name:       "Testing unassigning",
+			update:     func(req *reamaze.UpdateConversationRequest) { req.Conversation.Assignee = reamaze.Ptr("") },
+			wantStatus: reamaze.ReamazeStatusUnresolved, wantTags: []string{},
+		},
+	}
+	for _, tt := range steps {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &reamaze.UpdateConversationRequest{}
+			tt.update(req)
+			got, err := client.UpdateConversation(conversation.Slug, req)
+			if err != nil {
+				t.Fatalf("UpdateConversation() error = %v", err)
+			}
+			if got.Status != tt.wantStatus || len(got.TagList) != len(tt.wantTags) || (got.Assignee != nil) != tt.assigned {
+				t.Errorf("UpdateConversation() = status %v, tags %v, assignee %v", got.Status, got.TagList, got.Assignee)
+			}
+		})
+	}
+}
+
+func TestServer_Attachments(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	conversation := createConversation(t, client, "Invoice")
+
+	var req reamaze.CreateMessageRequest
+	req.Message.Body = "Your invoice"
+	if err := req.Message.Attach(strings.NewReader("%PDF-1.4"), "invoice 1.pdf", "application/pdf"); err != nil {
+		t.Fatalf("MessageRequest.Attach() error = %v", err)
+	}
+	req.Message.Attachments = append(req.Message.Attachments, "https://example.com/logo.png")
+	if _, err := client.CreateMessage(conversation.Slug, &req); err != nil {
+		t.Fatalf("CreateMessage() error = %v", err)
+	}
+	messages, err := client.GetConversationMessages(conversation.Slug)
+	if err != nil {
+		t.Fatalf("GetConversationMessages() error = %v", err)
+	}
+	attachments := messages.Messages[0].Attachments
+	if len(attachments) != 2 || attachments[0].FileFileName != "invoice 1.pdf" || attachments[0].FileContentType != "application/pdf" ||
+		attachments[0].FileFileSize != 8 || attachments[1].URL != "https://example.com/logo.png" {
+		t.Fatalf("Attachments = %+v", attachments)
+	}
+
+	var file bytes.Buffer
+	if _, err := client.DownloadAttachment(attachments[0], &file); err != nil || file.String() != "%PDF-1.4" {
+		t.Errorf("DownloadAttachment() = %q, error = %v", file.String(), err)
+	}
+	if _, err := client.DownloadAttachment(reamaze.Attachment{URL: srv.URL + "/attachments/0/dummy"}, &file); !errors.Is(err, reamaze.ErrNotFound) {
+		t.Errorf("DownloadAttachment() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestServer_ConversationWorkflow(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	conversation := createConversation(t, client, "Refund", "orders")
+
+	held, err := client.HoldConversationUntil(conversation.Slug, time.Date(2030, 1, 2, 9, 0, 0, 0, time.UTC))
+	if err != nil || held.Status != reamaze.ReamazeStatusOnHold || held.HoldUntil != "2030-01-02T09:00:00Z" {
+		t.Errorf("HoldConversationUntil() = %+v, error = %v", held, err)
+	}
+	reopened, err := client.ReopenConversation(conversation.Slug)
+	if err != nil || reopened.Status != reamaze.ReamazeStatusUnresolved || reopened.HoldUntil != nil {
+		t.Errorf("ReopenConversation() = %+v, error = %v", reopened, err)
+	}
+	tagged, err := client.AddConversationTags(conversation.Slug, "vip", "refund")
+	if err != nil || strings.Join(tagged.TagList, ",") != "orders,vip,refund" {
+		t.Errorf("AddConversationTags() = %+v, error = %v", tagged, err)
+	}
+	untagged, err := client.RemoveConversationTags(conversation.Slug, "orders")
+	if err != nil || strings.Join(untagged.TagList, ",") != "vip,refund" {
+		t.Errorf("RemoveConversationTags() = %+v, error = %v", untagged, err)
+	}
+	if _, err := client.MoveConversationToCategory(conversation.Slug, "unknown"); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("MoveConversationToCategory() error = %v, want ErrValidation", err)
+	}
+	if _, err := client.AddConversationTags("unknown", "vip"); !errors.Is(err, reamaze.ErrNotFound) {
+		t.Errorf("AddConversationTags() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestServer_Replies(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+	srv.AddStaff(reamaze.StaffUser{Name: "Agent", Email: "agent@example.com"})
+	conversation := createConversation(t, client, "Refund")
+
+	note, err := client.AddInternalNote(conversation.Slug, "Checking", reamaze.WithReplyStaffUser("agent@example.com"))
+	if err != nil || note.Visibility != int(reamaze.ReamazeVisibilityInternalNote) || note.User.Email != "agent@example.com" {
+		t.Errorf("AddInternalNote() = %+v, error = %v", note, err)
+	}
+	// the retried reply with the same origin id isn't posted twice
+	for i := 0; i < 2; i++ {
+		reply, err := client.ReplyToConversation(conversation.Slug, "Refunded", reamaze.WithReplyOriginID("refund-1"), reamaze.WithReplySuppressAutoresolve())
+		if err != nil || reply.OriginID != "refund-1" || reply.Body != "Refunded" {
+			t.Errorf("ReplyToConversation() = %+v, error = %v", reply, err)
+		}
+	}
+	got, err := client.GetConversation(conversation.Slug)
+	if err != nil || got.MessageCount != 3 || got.Status != reamaze.ReamazeStatusUnresolved {
+		t.Errorf("GetConversation() = %+v, error = %v", got, err)
+	}
+}
@@ -0,0 +1,173 @@
+package reamazetest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+type incidentUpdate struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type incidentSystem struct {
+	ID       string `json:"id"`
+	SystemID string `json:"system_id"`
+	Status   string `json:"status"`
+	System   struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	} `json:"system"`
+}
+
+type incident struct {
+	ID               string           `json:"id"`
+	Title            string           `json:"title"`
+	AccountID        int              `json:"account_id"`
+	BrandID          int              `json:"brand_id"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+	Status           string           `json:"status"`
+	ExternalURL      string           `json:"external_url"`
+	Updates          []incidentUpdate `json:"updates"`
+	IncidentsSystems []incidentSystem `json:"incidents_systems"`
+}
+
+// active checks if the incident is not resolved yet
+func (i *incident) active() bool {
+	return i.Status != string(reamaze.ReamazeIncidentUpdateStatusResolved)
+}
+
+func (s *Server) handleIncidents(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		incidents := make([]incident, 0, len(s.incidents))
+		for i := len(s.incidents) - 1; i >= 0; i-- {
+			incidents = append(incidents, *s.incidents[i])
+		}
+		writeJSON(w, http.StatusOK, incidents)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createIncident(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		inc := s.findIncident(segments[0])
+		if inc == nil {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, inc)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateIncident(w, r, segments[0])
+	case len(segments) <= 1:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findIncident(id string) *incident {
+	for _, inc := range s.incidents {
+		if inc.ID == id {
+			return inc
+		}
+	}
+	return nil
+}
+
+func (s *Server) createIncident(w http.ResponseWriter, r *http.Request) {
+	var req reamaze.CreateIncidentRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Incident.Title) == 0 {
+		writeValidationError(w, "title", "can't be blank")
+		return
+	}
+	now := s.now()
+	inc := &incident{
+		ID:               strconv.Itoa(s.nextID()),
+		CreatedAt:        now,
+		Status:           string(reamaze.ReamazeIncidentUpdateSatusInvestigating),
+		Updates:          []incidentUpdate{},
+		IncidentsSystems: []incidentSystem{},
+	}
+	if !s.applyIncident(w, inc, reamaze.UpdateIncidentRequest(req)) {
+		return
+	}
+	s.incidents = append(s.incidents, inc)
+	writeJSON(w, http.StatusCreated, inc)
+}
+
+func (s *Server) updateIncident(w http.ResponseWriter, r *http.Request, id string) {
+	inc := s.findIncident(id)
+	if inc == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.UpdateIncidentRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.applyIncident(w, inc, req) {
+		return
+	}
+	writeJSON(w, http.StatusOK, inc)
+}
+
+// applyIncident applies the request to the incident, the incident status follows its latest update.
+// Nothing is changed and 422 is written when the request refers to unknown systems.
+func (s *Server) applyIncident(w http.ResponseWriter, inc *incident, req reamaze.UpdateIncidentRequest) bool {
+	for _, attr := range req.Incident.IncidentsSystemsAttributes {
+		if len(attr.ID) > 0 && inc.findSystem(attr.ID, "") < 0 {
+			writeValidationError(w, "incidents_systems", "is invalid")
+			return false
+		}
+		if len(attr.ID) == 0 && s.findSystem(attr.SystemID) == nil {
+			writeValidationError(w, "incidents_systems.system", "must exist")
+			return false
+		}
+	}
+	now := s.now()
+	if len(req.Incident.Title) > 0 {
+		inc.Title = req.Incident.Title
+	}
+	for _, attr := range req.Incident.UpdatesAttributes {
+		update := incidentUpdate{ID: strconv.Itoa(s.nextID()), Status: string(attr.Status), Message: attr.Message, CreatedAt: now}
+		if len(update.Status) == 0 {
+			update.Status = inc.Status
+		}
+		inc.Updates = append(inc.Updates, update)
+		inc.Status = update.Status
+	}
+	for _, attr := range req.Incident.IncidentsSystemsAttributes {
+		status := string(attr.Status)
+		if len(status) == 0 {
+			status = string(reamaze.ReamazeIncidentSystemStatusDegradedPerformance)
+		}
+		if i := inc.findSystem(attr.ID, attr.SystemID); i >= 0 {
+			inc.IncidentsSystems[i].Status = status
+			continue
+		}
+		sys := s.findSystem(attr.SystemID)
+		is := incidentSystem{ID: strconv.Itoa(s.nextID()), SystemID: sys.ID, Status: status}
+		is.System.ID = sys.ID
+		is.System.Title = sys.Title
+		inc.IncidentsSystems = append(inc.IncidentsSystems, is)
+	}
+	inc.UpdatedAt = now
+	return true
+}
+
+// findSystem returns index of the incident system with given id or system id, or -1
+func (i *incident) findSystem(id string, systemID string) int {
+	for n, is := range i.IncidentsSystems {
+		if (len(id) > 0 && is.ID == id) || (len(id) == 0 && is.SystemID == systemID) {
+			return n
+		}
+	}
+	return -1
+}
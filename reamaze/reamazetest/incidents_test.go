@@ -0,0 +1,87 @@
+package reamazetest
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// incidentRequest builds incident request from JSON as its attributes are anonymous structs
+func incidentRequest(t *testing.T, body string) *reamaze.UpdateIncidentRequest {
+	t.Helper()
+	var req reamaze.UpdateIncidentRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	return &req
+}
+
+func TestServer_Incidents(t *testing.T) {
+	srv, client := newTestClient(t)
+	apiID := srv.AddSystem("API")
+	webID := srv.AddSystem("Website")
+
+	unknown := reamaze.CreateIncidentRequest(*incidentRequest(t, `{"incident":{"title":"Outage","incidents_systems_attributes":[{"system_id":"unknown","status":"major_outage"}]}}`))
+	if _, err := client.CreateIncident(&unknown); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateIncident() unknown system error = %v, want ErrValidation", err)
+	}
+
+	req := reamaze.CreateIncidentRequest(*incidentRequest(t, `{"incident":{
+		"title":"API outage",
+		"updates_attributes":[{"status":"investigating","message":"We are looking into it"}],
+		"incidents_systems_attributes":[{"system_id":"`+apiID+`","status":"major_outage"},{"system_id":"`+webID+`","status":"degraded_performance"}]
+	}}`))
+	created, err := client.CreateIncident(&req)
+	if err != nil {
+		t.Fatalf("CreateIncident() error = %v", err)
+	}
+	if created.Status != "investigating" || len(created.Updates) != 1 || len(created.IncidentsSystems) != 2 || created.IncidentsSystems[0].System.Title != "API" {
+		t.Errorf("CreateIncident() = %+v", created)
+	}
+
+	systemStatuses := func() map[string]string {
+		t.Helper()
+		systems, err := client.GetSystems()
+		if err != nil {
+			t.Fatalf("GetSystems() error = %v", err)
+		}
+		statuses := map[string]string{}
+		for _, s := range *systems {
+			statuses[s.Title] = s.Status
+		}
+		return statuses
+	}
+	if got := systemStatuses(); got["API"] != "major_outage" || got["Website"] != "degraded_performance" {
+		t.Errorf("GetSystems() statuses = %v", got)
+	}
+
+	update := incidentRequest(t, `{"incident":{
+		"updates_attributes":[{"status":"monitoring","message":"Fix deployed"}],
+		"incidents_systems_attributes":[{"id":"`+created.IncidentsSystems[1].ID+`","status":"operational"}]
+	}}`)
+	updated, err := client.UpdateIncident(created.ID, update)
+	if err != nil || updated.Status != "monitoring" || updated.Title != "API outage" || len(updated.Updates) != 2 {
+		t.Errorf("UpdateIncident() = %+v, error = %v", updated, err)
+	}
+	if got := systemStatuses(); got["API"] != "major_outage" || got["Website"] != "operational" {
+		t.Errorf("GetSystems() statuses after update = %v", got)
+	}
+
+	resolve := incidentRequest(t, `{"incident":{"updates_attributes":[{"status":"resolved","message":"All good"}]}}`)
+	if _, err := client.UpdateIncident(created.ID, resolve); err != nil {
+		t.Fatalf("UpdateIncident() error = %v", err)
+	}
+	if got := systemStatuses(); got["API"] != "operational" {
+		t.Errorf("GetSystems() statuses after resolve = %v", got)
+	}
+	incidents, err := client.GetIncidents()
+	if err != nil || len(*incidents) != 1 || (*incidents)[0].Status != "resolved" {
+		t.Errorf("GetIncidents() = %+v, error = %v", incidents, err)
+	}
+	got, err := client.GetIncident(created.ID)
+	if err != nil || got.Status != "resolved" || len(got.Updates) != 3 {
+		t.Errorf("GetIncident() = %+v, error = %v", got, err)
+	}
+}
@@ -0,0 +1,84 @@
+package reamazetest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+func (s *Server) handleResponseTemplates(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		templates := make([]reamaze.GetResponseTemplateResponse, 0, len(s.responseTemplates))
+		for _, t := range s.responseTemplates {
+			templates = append(templates, *t)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"response_templates": templates})
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createResponseTemplate(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		t := s.findResponseTemplate(segments[0])
+		if t == nil {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, t)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateResponseTemplate(w, r, segments[0])
+	case len(segments) <= 1:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findResponseTemplate(id string) *reamaze.GetResponseTemplateResponse {
+	for _, t := range s.responseTemplates {
+		if strconv.Itoa(t.ID) == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) createResponseTemplate(w http.ResponseWriter, r *http.Request) {
+	var req reamaze.CreateResponseTemplateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.ResponseTemplate.Name) == 0 {
+		writeValidationError(w, "name", "can't be blank")
+		return
+	}
+	if len(req.ResponseTemplate.Body) == 0 {
+		writeValidationError(w, "body", "can't be blank")
+		return
+	}
+	t := &reamaze.GetResponseTemplateResponse{
+		ID:   s.nextID(),
+		Name: req.ResponseTemplate.Name,
+		Body: req.ResponseTemplate.Body,
+	}
+	s.responseTemplates = append(s.responseTemplates, t)
+	writeJSON(w, http.StatusCreated, t)
+}
+
+func (s *Server) updateResponseTemplate(w http.ResponseWriter, r *http.Request, id string) {
+	t := s.findResponseTemplate(id)
+	if t == nil {
+		writeNotFound(w)
+		return
+	}
+	var req reamaze.UpdateResponseTemplateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.ResponseTemplate.Name) > 0 {
+		t.Name = req.ResponseTemplate.Name
+	}
+	if len(req.ResponseTemplate.Body) > 0 {
+		t.Body = req.ResponseTemplate.Body
+	}
+	writeJSON(w, http.StatusOK, t)
+}
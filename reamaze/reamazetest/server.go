@@ -0,0 +1,264 @@
+// Package reamazetest provides an in-memory re:amaze API server for end-to-end tests of code using the reamaze package.
+//
+// The server keeps its state in memory and implements the conversations, messages, contacts, notes, articles,
+// staff, channels, incidents, systems and response templates endpoints, so data created through one call
+// is returned by the following ones. Attachments sent as data URIs are served back at the URLs listed in messages.
+//
+//	srv := reamazetest.NewServer()
+//	defer srv.Close()
+//	srv.AddChannel(reamaze.GetChannelResponse{Name: "Support", Slug: "support", Channel: reamaze.ReamazeChannelEmail})
+//	client, err := srv.NewClient()
+package reamazetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+const (
+	// Email of the staff user the server accepts API requests from
+	Email = "admin@example.com"
+	// APIToken accepted by the server together with Email
+	APIToken = "reamazetest-token"
+	// Brand used by clients created with NewClient
+	Brand = "reamazetest"
+	// DefaultPageSize is the number of items returned per page by list endpoints
+	DefaultPageSize = 30
+
+	apiPrefix = "/api/v1/"
+)
+
+// Server is an in-memory re:amaze API
+type Server struct {
+	*httptest.Server
+	// PageSize is the number of items returned per page by list endpoints, set it before making requests
+	PageSize int
+
+	mu                sync.Mutex
+	clock             func() time.Time
+	lastTime          time.Time
+	lastID            int
+	conversations     []*conversation
+	messages          []*message
+	contacts          []*contact
+	articles          []*reamaze.ReamazeArticle
+	staff             []*reamaze.StaffUser
+	channels          []*reamaze.GetChannelResponse
+	systems           []*system
+	incidents         []*incident
+	responseTemplates []*reamaze.GetResponseTemplateResponse
+	attachments       []*attachment
+}
+
+// NewServer starts a new Server, the staff user authenticated with Email and APIToken is created upfront.
+// Callers should call Close when finished to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		PageSize: DefaultPageSize,
+		clock:    time.Now,
+	}
+	s.staff = append(s.staff, &reamaze.StaffUser{
+		Name:              "Admin",
+		DisplayName:       "Admin",
+		Email:             Email,
+		NotificationEmail: Email,
+		CreatedAt:         s.now(),
+		Role:              reamaze.StaffRole{Name: "Administrator", Admin: true},
+	})
+	s.Server = httptest.NewServer(http.HandlerFunc(s.route))
+	return s
+}
+
+// NewClient creates reamaze.Client authenticated against the Server, opts are applied after the ones pointing the client at the Server
+func (s *Server) NewClient(opts ...reamaze.ClientOption) (*reamaze.Client, error) {
+	opts = append([]reamaze.ClientOption{reamaze.WithBaseURL(s.URL), reamaze.WithHTTPClient(s.Client())}, opts...)
+	return reamaze.NewClient(Email, APIToken, Brand, opts...)
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, attachmentsPrefix) {
+		s.serveAttachment(w, r)
+		return
+	}
+	email, token, ok := r.BasicAuth()
+	if !ok || email != Email || token != APIToken {
+		writeError(w, http.StatusUnauthorized, "You need to sign in or sign up before continuing.")
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeNotFound(w)
+		return
+	}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch segments[0] {
+	case "conversations":
+		s.handleConversations(w, r, segments[1:])
+	case "messages":
+		s.handleMessages(w, r, segments[1:])
+	case "contacts":
+		s.handleContacts(w, r, segments[1:])
+	case "articles":
+		s.handleArticles(w, r, segments[1:])
+	case "staff":
+		s.handleStaff(w, r, segments[1:])
+	case "channels":
+		s.handleChannels(w, r, segments[1:])
+	case "incidents":
+		s.handleIncidents(w, r, segments[1:])
+	case "systems":
+		s.handleSystems(w, r, segments[1:])
+	case "response_templates":
+		s.handleResponseTemplates(w, r, segments[1:])
+	default:
+		writeNotFound(w)
+	}
+}
+
+// SetClock sets the function returning current time used for created_at and updated_at of new items, it's time.Now by default.
+// It's useful for creating items dated in the past e.g. to test date filters.
+func (s *Server) SetClock(clock func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock = clock
+	s.lastTime = time.Time{}
+}
+
+// now returns current time with millisecond precision the API uses, times are strictly increasing
+// so that ordering by created_at or updated_at is deterministic even for requests made within the same millisecond
+func (s *Server) now() time.Time {
+	t := s.clock().UTC().Truncate(time.Millisecond)
+	if !t.After(s.lastTime) {
+		t = s.lastTime.Add(time.Millisecond)
+	}
+	s.lastTime = t
+	return t
+}
+
+// nextID returns unique id, ids are shared by all resources
+func (s *Server) nextID() int {
+	s.lastID++
+	return s.lastID
+}
+
+// findStaff returns staff user with given email or nil
+func (s *Server) findStaff(email string) *reamaze.StaffUser {
+	for _, staff := range s.staff {
+		if strings.EqualFold(staff.Email, email) {
+			return staff
+		}
+	}
+	return nil
+}
+
+// staffUser returns staff user with given email as reamaze.User, the API user is returned when email is empty
+func (s *Server) staffUser(email string) (reamaze.User, bool) {
+	if len(email) == 0 {
+		email = Email
+	}
+	staff := s.findStaff(email)
+	if staff == nil {
+		return reamaze.User{}, false
+	}
+	return reamaze.User{Name: staff.Name, Email: staff.Email, DisplayName: staff.DisplayName, Staff: true}, true
+}
+
+// decode reads JSON request body into v, it responds with 400 and returns false when the body is malformed
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// writeValidationError responds with 422 and errors keyed by field the way re:amaze does
+func writeValidationError(w http.ResponseWriter, field string, message string) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]map[string][]string{"errors": {field: {message}}})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not found")
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+}
+
+// paginate returns items of the page requested with page query parameter together with page count
+func paginate[T any](r *http.Request, items []T, pageSize int) ([]T, int) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageCount := (len(items) + pageSize - 1) / pageSize
+	start := (page - 1) * pageSize
+	if start >= len(items) {
+		return []T{}, pageCount
+	}
+	end := start + pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end], pageCount
+}
+
+// dateRange parses start_date and end_date query parameters, end date is inclusive
+func dateRange(r *http.Request) (time.Time, time.Time) {
+	var start, end time.Time
+	if t, err := time.Parse("2006-01-02", r.URL.Query().Get("start_date")); err == nil {
+		start = t
+	}
+	if t, err := time.Parse("2006-01-02", r.URL.Query().Get("end_date")); err == nil {
+		end = t.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+func inRange(t, start, end time.Time) bool {
+	if !start.IsZero() && t.Before(start) {
+		return false
+	}
+	if !end.IsZero() && !t.Before(end) {
+		return false
+	}
+	return true
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns title into URL friendly slug e.g. "Hello, World" into "hello-world"
+func slugify(title string, fallback string) string {
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(slug) == 0 {
+		return fallback
+	}
+	return slug
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,180 @@
+package reamazetest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// newTestClient starts Server closed together with the test and returns it with a client pointed at it
+func newTestClient(t *testing.T) (*Server, *reamaze.Client) {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("Server.NewClient() error = %v", err)
+	}
+	return srv, client
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client, err := reamaze.NewClient("other@example.com", "wrong", Brand, reamaze.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("reamaze.NewClient() error = %v", err)
+	}
+	if _, err := client.GetChannels(); !errors.Is(err, reamaze.ErrUnauthorized) {
+		t.Errorf("GetChannels() error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestServer_NotFound(t *testing.T) {
+	srv, client := newTestClient(t)
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "Testing unknown conversation", call: func() error { _, err := client.GetConversation("dummy"); return err }},
+		{name: "Testing unknown contact", call: func() error { _, err := client.GetContact("dummy@example.com"); return err }},
+		{name: "Testing unknown article", call: func() error { _, err := client.GetArticle("dummy"); return err }},
+		{name: "Testing unknown channel", call: func() error { _, err := client.GetChannel("dummy"); return err }},
+		{name: "Testing unknown incident", call: func() error { _, err := client.GetIncident("dummy"); return err }},
+		{name: "Testing unknown response template", call: func() error { _, err := client.GetResponseTemplate("1"); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, reamaze.ErrNotFound) {
+				t.Errorf("error = %v, want ErrNotFound", err)
+			}
+		})
+	}
+	resp, err := srv.Client().Get(srv.URL + "/dummy")
+	if err != nil {
+		t.Fatalf("GET /dummy error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("GET /dummy without credentials status = %v, want %v", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.PageSize = 2
+	for _, name := range []string{"One", "Two", "Three", "Four"} {
+		srv.AddStaff(reamaze.StaffUser{Name: name, Email: name + "@example.com"})
+	}
+	resp, err := client.GetStaff(reamaze.WithStaffPage(3))
+	if err != nil {
+		t.Fatalf("GetStaff() error = %v", err)
+	}
+	if resp.PageCount != 3 || resp.TotalCount != 5 || len(resp.Staff) != 1 || resp.Staff[0].Name != "Four" {
+		t.Errorf("GetStaff() = %+v", resp)
+	}
+	var names []string
+	it := client.StaffIter(context.Background())
+	for it.Next() {
+		names = append(names, it.Value().Name)
+	}
+	if it.Err() != nil || len(names) != 5 || it.Page() != 3 {
+		t.Errorf("StaffIter() = %v, page %v, error = %v", names, it.Page(), it.Err())
+	}
+}
+
+func TestServer_Staff(t *testing.T) {
+	_, client := newTestClient(t)
+	req := &reamaze.CreateStaffRequest{}
+	req.Staff.Name = "Jane"
+	req.Staff.Email = "jane@example.com"
+	created, err := client.CreateStaff(req)
+	if err != nil {
+		t.Fatalf("CreateStaff() error = %v", err)
+	}
+	if created.Email != "jane@example.com" || created.DisplayName != "Jane" || created.CreatedAt.IsZero() {
+		t.Errorf("CreateStaff() = %+v", created)
+	}
+	if _, err := client.CreateStaff(req); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateStaff() duplicate error = %v, want ErrValidation", err)
+	}
+	staff, err := client.GetStaff()
+	if err != nil || staff.TotalCount != 2 || staff.Staff[0].Email != Email {
+		t.Errorf("GetStaff() = %+v, error = %v", staff, err)
+	}
+}
+
+func TestServer_Channels(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddChannel(reamaze.GetChannelResponse{Name: "Customer Support", Email: "support@example.com", Channel: reamaze.ReamazeChannelEmail})
+	channels, err := client.GetChannels()
+	if err != nil || channels.TotalCount != 1 || channels.Channels[0].Slug != "customer-support" {
+		t.Fatalf("GetChannels() = %+v, error = %v", channels, err)
+	}
+	channel, err := client.GetChannel("customer-support")
+	if err != nil || channel.Channel != reamaze.ReamazeChannelEmail || channel.Email != "support@example.com" {
+		t.Errorf("GetChannel() = %+v, error = %v", channel, err)
+	}
+}
+
+func TestServer_Articles(t *testing.T) {
+	_, client := newTestClient(t)
+	req := &reamaze.CreateArticleRequest{}
+	req.Article.Title = "How to reset password"
+	req.Article.Body = "Click forgot password"
+	created, err := client.CreateArticle(req)
+	if err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	if created.Author.Email != Email || len(created.Slug) == 0 || len(created.URL) == 0 {
+		t.Errorf("CreateArticle() = %+v", created)
+	}
+	draft := &reamaze.CreateArticleRequest{}
+	draft.Article.Title = "Shipping"
+	draft.Article.Status = reamaze.ReamazeArticleStatusDraft
+	if _, err := client.CreateArticle(draft); err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	untitled := &reamaze.CreateArticleRequest{}
+	untitled.Article.Body = "dummy"
+	if _, err := client.CreateArticle(untitled); !errors.Is(err, reamaze.ErrValidation) {
+		t.Errorf("CreateArticle() without title error = %v, want ErrValidation", err)
+	}
+	got, err := client.GetArticle(created.Slug)
+	if err != nil || got.Title != "How to reset password" {
+		t.Errorf("GetArticle() = %+v, error = %v", got, err)
+	}
+	found, err := client.GetArticles(reamaze.WithArticleQuery("password"))
+	if err != nil || found.TotalCount != 1 || found.Articles[0].Slug != created.Slug {
+		t.Errorf("GetArticles() query = %+v, error = %v", found, err)
+	}
+	drafts, err := client.GetArticles(reamaze.WithArticleStatus(reamaze.ReamazeArticleStatusDraft))
+	if err != nil || drafts.TotalCount != 1 || drafts.Articles[0].Title != "Shipping" {
+		t.Errorf("GetArticles() status = %+v, error = %v", drafts, err)
+	}
+}
+
+func TestServer_ResponseTemplates(t *testing.T) {
+	_, client := newTestClient(t)
+	req := &reamaze.CreateResponseTemplateRequest{}
+	req.ResponseTemplate.Name = "Greeting"
+	req.ResponseTemplate.Body = "Hello!"
+	created, err := client.CreateResponseTemplate(req)
+	if err != nil || created.ID == 0 {
+		t.Fatalf("CreateResponseTemplate() = %+v, error = %v", created, err)
+	}
+	update := &reamaze.UpdateResponseTemplateRequest{}
+	update.ResponseTemplate.Body = "Hi!"
+	updated, err := client.UpdateResponseTemplate(strconv.Itoa(created.ID), update)
+	if err != nil || updated.Name != "Greeting" || updated.Body != "Hi!" {
+		t.Errorf("UpdateResponseTemplate() = %+v, error = %v", updated, err)
+	}
+	templates, err := client.GetResponseTemplates()
+	if err != nil || len(templates.ResponseTemplates) != 1 || templates.ResponseTemplates[0].Body != "Hi!" {
+		t.Errorf("GetResponseTemplates() = %+v, error = %v", templates, err)
+	}
+}
@@ -0,0 +1,81 @@
+package reamazetest
+
+import (
+	"net/http"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// AddStaff adds staff user to the Server, e.g. to assign conversations to, and returns it as stored
+func (s *Server) AddStaff(staff reamaze.StaffUser) reamaze.StaffUser {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.addStaff(staff)
+}
+
+func (s *Server) addStaff(staff reamaze.StaffUser) *reamaze.StaffUser {
+	if len(staff.DisplayName) == 0 {
+		staff.DisplayName = staff.Name
+	}
+	if len(staff.NotificationEmail) == 0 {
+		staff.NotificationEmail = staff.Email
+	}
+	if staff.CreatedAt.IsZero() {
+		staff.CreatedAt = s.now()
+	}
+	stored := &staff
+	s.staff = append(s.staff, stored)
+	return stored
+}
+
+func (s *Server) handleStaff(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listStaff(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createStaff(w, r)
+	case len(segments) == 0:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) listStaff(w http.ResponseWriter, r *http.Request) {
+	staff := make([]reamaze.StaffUser, 0, len(s.staff))
+	for _, st := range s.staff {
+		staff = append(staff, *st)
+	}
+	page, pageCount := paginate(r, staff, s.PageSize)
+	writeJSON(w, http.StatusOK, reamaze.GetStaffResponse{
+		PageSize:   s.PageSize,
+		PageCount:  pageCount,
+		TotalCount: len(staff),
+		Staff:      page,
+	})
+}
+
+func (s *Server) createStaff(w http.ResponseWriter, r *http.Request) {
+	var req reamaze.CreateStaffRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Staff.Name) == 0 {
+		writeValidationError(w, "name", "can't be blank")
+		return
+	}
+	if len(req.Staff.Email) == 0 {
+		writeValidationError(w, "email", "can't be blank")
+		return
+	}
+	if s.findStaff(req.Staff.Email) != nil {
+		writeValidationError(w, "email", "has already been taken")
+		return
+	}
+	staff := s.addStaff(reamaze.StaffUser{
+		Name:  req.Staff.Name,
+		Email: req.Staff.Email,
+		Role:  reamaze.StaffRole{Name: "Staff", Default: true},
+	})
+	writeJSON(w, http.StatusCreated, staff)
+}
@@ -0,0 +1,83 @@
+package reamazetest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+type system struct {
+	ID              string     `json:"id"`
+	Title           string     `json:"title"`
+	AccountID       int        `json:"account_id"`
+	BrandID         int        `json:"brand_id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	Status          string     `json:"status"`
+	ActiveIncidents []incident `json:"active_incidents"`
+}
+
+// systemStatusSeverity orders system statuses, the most severe status of active incidents becomes the system status
+var systemStatusSeverity = map[string]int{
+	string(reamaze.ReamazeIncidentSystemStatusOperational):         0,
+	string(reamaze.ReamazeIncidentSystemStatusUnderMaintenance):    1,
+	string(reamaze.ReamazeIncidentSystemStatusDegradedPerformance): 2,
+	string(reamaze.ReamazeIncidentSystemStatusPartialOutage):       3,
+	string(reamaze.ReamazeIncidentSystemStatusMajorOutage):         4,
+}
+
+// AddSystem adds status page system to the Server and returns its ID, incidents can refer only to existing systems
+func (s *Server) AddSystem(title string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	sys := &system{ID: strconv.Itoa(s.nextID()), Title: title, CreatedAt: now, UpdatedAt: now}
+	s.systems = append(s.systems, sys)
+	return sys.ID
+}
+
+func (s *Server) findSystem(id string) *system {
+	for _, sys := range s.systems {
+		if sys.ID == id {
+			return sys
+		}
+	}
+	return nil
+}
+
+func (s *Server) handleSystems(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		systems := make([]system, 0, len(s.systems))
+		for _, sys := range s.systems {
+			systems = append(systems, s.systemStatus(*sys))
+		}
+		writeJSON(w, http.StatusOK, systems)
+	case len(segments) == 0:
+		writeMethodNotAllowed(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+// systemStatus fills status and active incidents of the system, it's operational when no active incident affects it
+func (s *Server) systemStatus(sys system) system {
+	sys.Status = string(reamaze.ReamazeIncidentSystemStatusOperational)
+	sys.ActiveIncidents = []incident{}
+	for _, inc := range s.incidents {
+		if !inc.active() {
+			continue
+		}
+		i := inc.findSystem("", sys.ID)
+		if i < 0 {
+			continue
+		}
+		sys.ActiveIncidents = append(sys.ActiveIncidents, *inc)
+		if status := inc.IncidentsSystems[i].Status; systemStatusSeverity[status] > systemStatusSeverity[sys.Status] {
+			sys.Status = status
+		}
+	}
+	return sys
+}
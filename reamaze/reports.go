@@ -1,26 +1,33 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // GetReportsVolume returns a daily volume count
 // The start and end dates of the report will default to the last 30 days. Time frames can be no smaller than 1 day and no larger than 1 year.
 // https://www.reamaze.com/api/get_reports_volume
 func (c *Client) GetReportsVolume(o ...ReportsOption) (*GetReportsVolumeResponse, error) {
-	var response *GetReportsVolumeResponse
-	settings, _ := newReportsSettings(o)
-	urlEndpoint := reportsEndpoint + "/volume" + settings.GetQuery()
+	return c.GetReportsVolumeWithContext(context.Background(), o...)
+}
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+// GetReportsVolumeWithContext is like GetReportsVolume but uses ctx for the underlying request.
+func (c *Client) GetReportsVolumeWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsVolumeResponse, error) {
+	var response *GetReportsVolumeResponse
+	settings, err := newReportsSettings(o)
 	if err != nil {
 		return nil, err
 	}
-
-	err = json.Unmarshal(resp, &response)
-	if err != nil {
-		return nil, err
+	// ranges longer than a year are requested in windows and merged
+	for _, window := range settings.windows(time.Now()) {
+		var part *GetReportsVolumeResponse
+		if err := c.getReport(ctx, "/volume", window, &part); err != nil {
+			return nil, err
+		}
+		response = mergeReportsVolume(response, part)
 	}
 	return response, nil
 }
@@ -28,10 +35,18 @@ func (c *Client) GetReportsVolume(o ...ReportsOption) (*GetReportsVolumeResponse
 // GetReportsResponseTime Returns a daily response time metric and a response times summary object. Response times are reported in seconds.
 // https://www.reamaze.com/api/get_reports_response_time
 func (c *Client) GetReportsResponseTime(o ...ReportsOption) (*GetReportsResponseTimeRespone, error) {
+	return c.GetReportsResponseTimeWithContext(context.Background(), o...)
+}
+
+// GetReportsResponseTimeWithContext is like GetReportsResponseTime but uses ctx for the underlying request.
+func (c *Client) GetReportsResponseTimeWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsResponseTimeRespone, error) {
 	var response *GetReportsResponseTimeRespone
-	settings, _ := newReportsSettings(o)
+	settings, err := newReportsSettings(o)
+	if err != nil {
+		return nil, err
+	}
 	urlEndpoint := reportsEndpoint + "/response_time" + settings.GetQuery()
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +62,18 @@ func (c *Client) GetReportsResponseTime(o ...ReportsOption) (*GetReportsResponse
 // The start and end dates of the report will default to the last 30 days. Time frames can be no smaller than 1 day and no larger than 1 year.
 // https://www.reamaze.com/api/get_reports_staff
 func (c *Client) GetReportsStaff(o ...ReportsOption) (*GetReportsStaffResponse, error) {
+	return c.GetReportsStaffWithContext(context.Background(), o...)
+}
+
+// GetReportsStaffWithContext is like GetReportsStaff but uses ctx for the underlying request.
+func (c *Client) GetReportsStaffWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsStaffResponse, error) {
 	var response *GetReportsStaffResponse
-	settings, _ := newReportsSettings(o)
+	settings, err := newReportsSettings(o)
+	if err != nil {
+		return nil, err
+	}
 	urlEndpoint := reportsEndpoint + "/staff" + settings.GetQuery()
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -66,17 +89,23 @@ func (c *Client) GetReportsStaff(o ...ReportsOption) (*GetReportsStaffResponse,
 // The start and end dates of the report will default to the last 30 days. Time frames can be no smaller than 1 day and no larger than 1 year.
 // https://www.reamaze.com/api/get_reports_tags
 func (c *Client) GetReportsTags(o ...ReportsOption) (*GetReportsTagsResponse, error) {
+	return c.GetReportsTagsWithContext(context.Background(), o...)
+}
+
+// GetReportsTagsWithContext is like GetReportsTags but uses ctx for the underlying request.
+func (c *Client) GetReportsTagsWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsTagsResponse, error) {
 	var response *GetReportsTagsResponse
-	settings, _ := newReportsSettings(o)
-	urlEndpoint := reportsEndpoint + "/tags" + settings.GetQuery()
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	settings, err := newReportsSettings(o)
 	if err != nil {
 		return nil, err
 	}
-
-	err = json.Unmarshal(resp, &response)
-	if err != nil {
-		return nil, err
+	// ranges longer than a year are requested in windows and merged
+	for _, window := range settings.windows(time.Now()) {
+		var part *GetReportsTagsResponse
+		if err := c.getReport(ctx, "/tags", window, &part); err != nil {
+			return nil, err
+		}
+		response = mergeReportsTags(response, part)
 	}
 	return response, nil
 }
@@ -85,11 +114,18 @@ func (c *Client) GetReportsTags(o ...ReportsOption) (*GetReportsTagsResponse, er
 // The start and end dates of the report will default to the last 30 days. Time frames can be no smaller than 1 day and no larger than 1 year.
 // https://www.reamaze.com/api/get_reports_channel_summary
 func (c *Client) GetReportsChannelSummary(o ...ReportsOption) (*GetReportsChannelSummaryResponse, error) {
-	var response *GetReportsChannelSummaryResponse
-	settings, _ := newReportsSettings(o)
+	return c.GetReportsChannelSummaryWithContext(context.Background(), o...)
+}
 
+// GetReportsChannelSummaryWithContext is like GetReportsChannelSummary but uses ctx for the underlying request.
+func (c *Client) GetReportsChannelSummaryWithContext(ctx context.Context, o ...ReportsOption) (*GetReportsChannelSummaryResponse, error) {
+	var response *GetReportsChannelSummaryResponse
+	settings, err := newReportsSettings(o)
+	if err != nil {
+		return nil, err
+	}
 	urlEndpoint := reportsEndpoint + "/channel_summary" + settings.GetQuery()
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -100,3 +136,48 @@ func (c *Client) GetReportsChannelSummary(o ...ReportsOption) (*GetReportsChanne
 	}
 	return response, nil
 }
+
+// getReport requests report at path with settings and decodes it into v
+func (c *Client) getReport(ctx context.Context, path string, settings ReamazeReportOptions, v any) error {
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, reportsEndpoint+path+settings.GetQuery(), []byte{})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resp, v)
+}
+
+// mergeReportsVolume adds daily counts of part to response, part is returned as it is when response is nil
+func mergeReportsVolume(response, part *GetReportsVolumeResponse) *GetReportsVolumeResponse {
+	if response == nil {
+		return part
+	}
+	if part == nil {
+		return response
+	}
+	if response.ConversationCounts == nil {
+		response.ConversationCounts = map[string]int{}
+	}
+	for date, count := range part.ConversationCounts {
+		response.ConversationCounts[date] += count
+	}
+	response.EndDate = part.EndDate
+	return response
+}
+
+// mergeReportsTags adds tag counts of part to response, part is returned as it is when response is nil
+func mergeReportsTags(response, part *GetReportsTagsResponse) *GetReportsTagsResponse {
+	if response == nil {
+		return part
+	}
+	if part == nil {
+		return response
+	}
+	if response.Tags == nil {
+		response.Tags = map[string]int{}
+	}
+	for tag, count := range part.Tags {
+		response.Tags[tag] += count
+	}
+	response.EndDate = part.EndDate
+	return response
+}
@@ -0,0 +1,35 @@
+package reports
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// CSVHeader lists columns written by WriteCSV, label columns not used by a metric are left empty
+var CSVHeader = []string{"metric", LabelDate, LabelPeriod, LabelTag, LabelChannel, LabelBrand, "value"}
+
+// WriteCSV writes a row per Sample of the snapshot to w, preceded by CSVHeader
+func WriteCSV(w io.Writer, s *Snapshot) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, sample := range s.Samples() {
+		row := make([]string, len(CSVHeader))
+		row[0] = sample.Metric
+		for _, label := range sample.Labels {
+			for i, column := range CSVHeader {
+				if column == label.Name {
+					row[i] = label.Value
+				}
+			}
+		}
+		row[len(row)-1] = strconv.FormatFloat(sample.Value, 'f', -1, 64)
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
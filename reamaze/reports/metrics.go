@@ -0,0 +1,147 @@
+package reports
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// ContentType is the content type of OpenMetrics text format served by Handler
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// help describes metrics in OpenMetrics HELP lines
+var help = map[string]string{
+	MetricConversations:            "Conversations per day.",
+	MetricResponseTime:             "Average response time per day in seconds.",
+	MetricResponseTimeAverage:      "Average response time of the period in seconds.",
+	MetricTagConversations:         "Conversations per tag.",
+	MetricChannelStaffResponses:    "Staff responses per channel.",
+	MetricChannelCustomerResponses: "Customer responses per channel.",
+	MetricChannelResponseTime:      "Average response time per channel in seconds.",
+	MetricChannelAppreciations:     "Appreciations per channel.",
+	MetricChannelActive:            "Active conversations per channel.",
+	MetricChannelResolved:          "Resolved conversations per channel.",
+	MetricChannelArchived:          "Archived conversations per channel.",
+	MetricChannelSatisfaction:      "Average satisfaction rating per channel.",
+	MetricChannelAverageThreadSize: "Average number of messages of conversations per channel.",
+	MetricUp:                       "Whether the last poll of the reports succeeded.",
+	MetricLastSuccessTimestamp:     "Time of the last successful poll of the reports.",
+}
+
+// WriteOpenMetrics writes samples in OpenMetrics text format, every metric as a gauge. Samples of the same metric must be
+// next to each other, as returned by Snapshot.Samples.
+func WriteOpenMetrics(w io.Writer, samples []Sample) error {
+	bw := bufio.NewWriter(w)
+	for i, sample := range samples {
+		if i == 0 || samples[i-1].Metric != sample.Metric {
+			if h, ok := help[sample.Metric]; ok {
+				bw.WriteString("# HELP " + sample.Metric + " " + h + "\n")
+			}
+			bw.WriteString("# TYPE " + sample.Metric + " gauge\n")
+		}
+		bw.WriteString(sample.Metric)
+		if len(sample.Labels) > 0 {
+			bw.WriteByte('{')
+			for j, label := range sample.Labels {
+				if j > 0 {
+					bw.WriteByte(',')
+				}
+				bw.WriteString(label.Name + `="` + escapeLabel(label.Value) + `"`)
+			}
+			bw.WriteByte('}')
+		}
+		bw.WriteString(" " + strconv.FormatFloat(sample.Value, 'g', -1, 64) + "\n")
+	}
+	bw.WriteString("# EOF\n")
+	return bw.Flush()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+// Handler polls re:amaze reports and serves the latest ones in OpenMetrics text format, see NewHandler
+type Handler struct {
+	src      Source
+	settings *Options
+
+	mu          sync.RWMutex
+	snapshot    *Snapshot
+	up          bool
+	lastSuccess time.Time
+}
+
+// NewHandler returns Handler polling reports of src, optional parameters are WithInterval(time.Duration),
+// WithReportsOptions(...reamaze.ReportsOption), WithReportsOptionsFunc(func() []reamaze.ReportsOption) and
+// WithErrorFunc(func(error)). Reports are polled by Run.
+func NewHandler(src Source, opts ...Option) *Handler {
+	return &Handler{src: src, settings: newSettings(opts)}
+}
+
+// Run polls the reports right away and then on every interval until ctx is done, it returns ctx.Err()
+func (h *Handler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(h.settings.Interval)
+	defer ticker.Stop()
+	for {
+		if err := h.Poll(ctx); err != nil && h.settings.Error != nil {
+			h.settings.Error(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches the reports once, the previous reports are kept and served when it fails
+func (h *Handler) Poll(ctx context.Context) error {
+	opts := h.settings.ReportsOptions
+	if h.settings.ReportsOptionsFunc != nil {
+		opts = append(append([]reamaze.ReportsOption{}, opts...), h.settings.ReportsOptionsFunc()...)
+	}
+	snapshot, err := Collect(ctx, h.src, opts...)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.up = err == nil
+	if err != nil {
+		return err
+	}
+	h.snapshot = snapshot
+	h.lastSuccess = snapshot.Time
+	return nil
+}
+
+// ServeHTTP writes the latest reports, along with MetricUp and MetricLastSuccessTimestamp
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	h.mu.RLock()
+	var samples []Sample
+	if h.snapshot != nil {
+		samples = h.snapshot.Samples()
+	}
+	up := 0.0
+	if h.up {
+		up = 1
+	}
+	samples = append(samples, Sample{Metric: MetricUp, Value: up})
+	if !h.lastSuccess.IsZero() {
+		samples = append(samples, Sample{Metric: MetricLastSuccessTimestamp, Value: float64(h.lastSuccess.UnixMilli()) / 1000})
+	}
+	h.mu.RUnlock()
+	w.Header().Set("Content-Type", ContentType)
+	WriteOpenMetrics(w, samples)
+}
@@ -0,0 +1,75 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// DefaultInterval is how often Handler polls the reports when WithInterval isn't used
+const DefaultInterval = 5 * time.Minute
+
+type Interval time.Duration
+type ReportsOptions []reamaze.ReportsOption
+type ReportsOptionsFunc func() []reamaze.ReportsOption
+type ErrorFunc func(error)
+
+// Option configures the Handler created by NewHandler
+type Option interface {
+	Apply(*Options)
+}
+
+type Options struct {
+	Interval           time.Duration
+	ReportsOptions     []reamaze.ReportsOption
+	ReportsOptionsFunc func() []reamaze.ReportsOption
+	Error              func(error)
+}
+
+func (w Interval) Apply(o *Options) {
+	if w > 0 {
+		o.Interval = time.Duration(w)
+	}
+}
+
+func (w ReportsOptions) Apply(o *Options) {
+	o.ReportsOptions = append(o.ReportsOptions, w...)
+}
+
+func (w ReportsOptionsFunc) Apply(o *Options) {
+	o.ReportsOptionsFunc = w
+}
+
+func (w ErrorFunc) Apply(o *Options) {
+	o.Error = w
+}
+
+// WithInterval sets how often the reports are polled, DefaultInterval by default
+func WithInterval(interval time.Duration) Interval {
+	return Interval(interval)
+}
+
+// WithReportsOptions sets options of the polled reports e.g. reamaze.WithReportsBrand("brand"). re:amaze reports
+// the last 30 days by default, note that date ranges given here are fixed, use WithReportsOptionsFunc for moving ones.
+func WithReportsOptions(o ...reamaze.ReportsOption) ReportsOptions {
+	return ReportsOptions(o)
+}
+
+// WithReportsOptionsFunc sets the function called on every poll for options of the polled reports, added to the ones
+// of WithReportsOptions. Use it for date ranges moving forward between polls e.g. reamaze.WithReportsLast7Days().
+func WithReportsOptionsFunc(f func() []reamaze.ReportsOption) ReportsOptionsFunc {
+	return ReportsOptionsFunc(f)
+}
+
+// WithErrorFunc sets the function called with errors of failed polls, e.g. for logging
+func WithErrorFunc(f func(error)) ErrorFunc {
+	return ErrorFunc(f)
+}
+
+func newSettings(opts []Option) *Options {
+	o := Options{Interval: DefaultInterval}
+	for _, opt := range opts {
+		opt.Apply(&o)
+	}
+	return &o
+}
@@ -0,0 +1,175 @@
+// Package reports exports re:amaze reports for monitoring, as CSV or as OpenMetrics served on /metrics.
+//
+// Collect fetches the volume, response time, tags and channel summary reports as a Snapshot, which is
+// flattened to Samples written by WriteCSV and WriteOpenMetrics. Handler polls the reports on an interval
+// and serves the latest Snapshot to Prometheus:
+//
+//	handler := reports.NewHandler(client, reports.WithInterval(10*time.Minute))
+//	go handler.Run(ctx)
+//	http.Handle("/metrics", handler)
+package reports
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+// Metric names of Samples, every one is a gauge
+const (
+	MetricConversations            = "reamaze_conversations"
+	MetricResponseTime             = "reamaze_response_time_seconds"
+	MetricResponseTimeAverage      = "reamaze_response_time_average_seconds"
+	MetricTagConversations         = "reamaze_tag_conversations"
+	MetricChannelStaffResponses    = "reamaze_channel_staff_responses"
+	MetricChannelCustomerResponses = "reamaze_channel_customer_responses"
+	MetricChannelResponseTime      = "reamaze_channel_average_response_time_seconds"
+	MetricChannelAppreciations     = "reamaze_channel_appreciations"
+	MetricChannelActive            = "reamaze_channel_active_conversations"
+	MetricChannelResolved          = "reamaze_channel_resolved_conversations"
+	MetricChannelArchived          = "reamaze_channel_archived_conversations"
+	MetricChannelSatisfaction      = "reamaze_channel_average_satisfaction_rating"
+	MetricChannelAverageThreadSize = "reamaze_channel_average_thread_size"
+	MetricUp                       = "reamaze_reports_up"
+	MetricLastSuccessTimestamp     = "reamaze_reports_last_success_timestamp_seconds"
+)
+
+// Label names of Samples
+const (
+	LabelDate    = "date"
+	LabelPeriod  = "period"
+	LabelTag     = "tag"
+	LabelChannel = "channel"
+	LabelBrand   = "brand"
+)
+
+// Source is the part of reamaze.API used to fetch reports, *reamaze.Client implements it
+type Source interface {
+	reamaze.ReportsService
+}
+
+// Snapshot holds reports fetched together by Collect
+type Snapshot struct {
+	Time           time.Time
+	Volume         *reamaze.GetReportsVolumeResponse
+	ResponseTime   *reamaze.GetReportsResponseTimeRespone
+	Tags           *reamaze.GetReportsTagsResponse
+	ChannelSummary *reamaze.GetReportsChannelSummaryResponse
+}
+
+// Label is a label of a Sample
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a report metric, e.g. number of conversations of a day
+type Sample struct {
+	Metric string
+	Labels []Label
+	Value  float64
+}
+
+// Collect fetches volume, response time, tags and channel summary reports with given options, e.g. reamaze.WithReportsLast7Days()
+func Collect(ctx context.Context, src Source, o ...reamaze.ReportsOption) (*Snapshot, error) {
+	s := &Snapshot{Time: time.Now()}
+	var err error
+	if s.Volume, err = src.GetReportsVolumeWithContext(ctx, o...); err != nil {
+		return nil, err
+	}
+	if s.ResponseTime, err = src.GetReportsResponseTimeWithContext(ctx, o...); err != nil {
+		return nil, err
+	}
+	if s.Tags, err = src.GetReportsTagsWithContext(ctx, o...); err != nil {
+		return nil, err
+	}
+	if s.ChannelSummary, err = src.GetReportsChannelSummaryWithContext(ctx, o...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Samples flattens the reports of the snapshot, samples of the same metric are next to each other and sorted by labels.
+// Reports missing in the snapshot are skipped.
+func (s *Snapshot) Samples() []Sample {
+	var samples []Sample
+	if s.Volume != nil {
+		for _, date := range sortedKeys(s.Volume.ConversationCounts) {
+			samples = append(samples, Sample{Metric: MetricConversations, Labels: []Label{{LabelDate, date}}, Value: float64(s.Volume.ConversationCounts[date])})
+		}
+	}
+	if s.ResponseTime != nil {
+		for _, date := range sortedKeys(s.ResponseTime.ResponseTimes) {
+			samples = append(samples, Sample{Metric: MetricResponseTime, Labels: []Label{{LabelDate, date}}, Value: s.ResponseTime.ResponseTimes[date]})
+		}
+		averages := s.ResponseTime.Summary.Averages
+		samples = append(samples,
+			Sample{Metric: MetricResponseTimeAverage, Labels: []Label{{LabelPeriod, "in_range"}}, Value: averages.InRange},
+			Sample{Metric: MetricResponseTimeAverage, Labels: []Label{{LabelPeriod, "this_month"}}, Value: averages.ThisMonth},
+			Sample{Metric: MetricResponseTimeAverage, Labels: []Label{{LabelPeriod, "this_week"}}, Value: averages.ThisWeek},
+		)
+	}
+	if s.Tags != nil {
+		for _, tag := range sortedKeys(s.Tags.Tags) {
+			samples = append(samples, Sample{Metric: MetricTagConversations, Labels: []Label{{LabelTag, tag}}, Value: float64(s.Tags.Tags[tag])})
+		}
+	}
+	if s.ChannelSummary != nil {
+		samples = append(samples, channelSamples(s.ChannelSummary)...)
+	}
+	return samples
+}
+
+// channelSamples returns samples of every channel metric, grouped by metric
+func channelSamples(summary *reamaze.GetReportsChannelSummaryResponse) []Sample {
+	type channel struct {
+		key     string
+		metrics map[string]float64
+		brand   string
+	}
+	metrics := []string{
+		MetricChannelStaffResponses, MetricChannelCustomerResponses, MetricChannelResponseTime, MetricChannelAppreciations,
+		MetricChannelActive, MetricChannelResolved, MetricChannelArchived, MetricChannelSatisfaction, MetricChannelAverageThreadSize,
+	}
+	var channels []channel
+	for _, key := range sortedKeys(summary.Channels) {
+		c := summary.Channels[key]
+		values := map[string]float64{
+			MetricChannelStaffResponses:    float64(c.StaffResponses),
+			MetricChannelCustomerResponses: float64(c.CustomerResponses),
+			MetricChannelAppreciations:     float64(c.Appreciations),
+			MetricChannelActive:            float64(c.ActiveConversations),
+			MetricChannelResolved:          float64(c.ResolvedConversations),
+			MetricChannelArchived:          float64(c.ArchivedConversations),
+			MetricChannelSatisfaction:      c.AverageSatisfactionRating,
+			MetricChannelAverageThreadSize: c.AverageThreadSize,
+		}
+		// response time is null for channels without responses
+		if seconds, ok := c.AverageResponseTimeSeconds.(float64); ok {
+			values[MetricChannelResponseTime] = seconds
+		}
+		channels = append(channels, channel{key: key, metrics: values, brand: c.Brand.Name})
+	}
+	var samples []Sample
+	for _, metric := range metrics {
+		for _, c := range channels {
+			value, ok := c.metrics[metric]
+			if !ok {
+				continue
+			}
+			samples = append(samples, Sample{Metric: metric, Labels: []Label{{LabelChannel, c.key}, {LabelBrand, c.brand}}, Value: value})
+		}
+	}
+	return samples
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
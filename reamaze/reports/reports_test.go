@@ -0,0 +1,205 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meant4/reamaze-go/reamaze"
+	"github.com/meant4/reamaze-go/reamaze/reamazemock"
+)
+
+// newMock returns mock answering report requests with small reports, requests fail with err when it's set
+func newMock(t *testing.T, err *error) *reamazemock.Mock {
+	t.Helper()
+	var summary reamaze.GetReportsChannelSummaryResponse
+	if e := json.Unmarshal([]byte(`{"channels":{"support":{"brand":{"name":"Shop"},"staff_responses":12,"customer_responses":10,`+
+		`"average_response_time_seconds":90.5,"active_conversations":3,"resolved_conversations":7,"average_thread_size":2.5},`+
+		`"chat":{"brand":{"name":"Shop \"EU\""},"average_response_time_seconds":null}}}`), &summary); e != nil {
+		t.Fatalf("json.Unmarshal() error = %v", e)
+	}
+	return &reamazemock.Mock{
+		GetReportsVolumeWithContextFunc: func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error) {
+			return &reamaze.GetReportsVolumeResponse{ConversationCounts: map[string]int{"2024-01-02": 5, "2024-01-01": 3}}, *err
+		},
+		GetReportsResponseTimeWithContextFunc: func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsResponseTimeRespone, error) {
+			r := &reamaze.GetReportsResponseTimeRespone{ResponseTimes: map[string]float64{"2024-01-01": 120.5}}
+			r.Summary.Averages.InRange = 120.5
+			return r, *err
+		},
+		GetReportsTagsWithContextFunc: func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsTagsResponse, error) {
+			return &reamaze.GetReportsTagsResponse{Tags: map[string]int{"vip": 2, "orders, eu": 4}}, *err
+		},
+		GetReportsChannelSummaryWithContextFunc: func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsChannelSummaryResponse, error) {
+			return &summary, *err
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var pollErr error
+	mock := newMock(t, &pollErr)
+	snapshot, err := Collect(context.Background(), mock, reamaze.WithReportsBrand("dummy"))
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, snapshot); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	want := `metric,date,period,tag,channel,brand,value
+reamaze_conversations,2024-01-01,,,,,3
+reamaze_conversations,2024-01-02,,,,,5
+reamaze_response_time_seconds,2024-01-01,,,,,120.5
+reamaze_response_time_average_seconds,,in_range,,,,120.5
+reamaze_response_time_average_seconds,,this_month,,,,0
+reamaze_response_time_average_seconds,,this_week,,,,0
+reamaze_tag_conversations,,,"orders, eu",,,4
+reamaze_tag_conversations,,,vip,,,2
+reamaze_channel_staff_responses,,,,chat,"Shop ""EU""",0
+reamaze_channel_staff_responses,,,,support,Shop,12
+reamaze_channel_customer_responses,,,,chat,"Shop ""EU""",0
+reamaze_channel_customer_responses,,,,support,Shop,10
+reamaze_channel_average_response_time_seconds,,,,support,Shop,90.5
+reamaze_channel_appreciations,,,,chat,"Shop ""EU""",0
+reamaze_channel_appreciations,,,,support,Shop,0
+reamaze_channel_active_conversations,,,,chat,"Shop ""EU""",0
+reamaze_channel_active_conversations,,,,support,Shop,3
+reamaze_channel_resolved_conversations,,,,chat,"Shop ""EU""",0
+reamaze_channel_resolved_conversations,,,,support,Shop,7
+reamaze_channel_archived_conversations,,,,chat,"Shop ""EU""",0
+reamaze_channel_archived_conversations,,,,support,Shop,0
+reamaze_channel_average_satisfaction_rating,,,,chat,"Shop ""EU""",0
+reamaze_channel_average_satisfaction_rating,,,,support,Shop,0
+reamaze_channel_average_thread_size,,,,chat,"Shop ""EU""",0
+reamaze_channel_average_thread_size,,,,support,Shop,2.5
+`
+	if buf.String() != want {
+		t.Errorf("WriteCSV() = %s, want %s", buf.String(), want)
+	}
+	calls := mock.Calls()
+	if len(calls) != 4 {
+		t.Errorf("Calls() = %v, want 4 reports", calls)
+	}
+}
+
+func TestWriteOpenMetrics(t *testing.T) {
+	samples := []Sample{
+		{Metric: MetricTagConversations, Labels: []Label{{LabelTag, "say \"hi\"\\\n"}}, Value: 2},
+		{Metric: MetricTagConversations, Labels: []Label{{LabelTag, "vip"}}, Value: 0.5},
+		{Metric: "dummy", Value: 1e21},
+	}
+	var buf bytes.Buffer
+	if err := WriteOpenMetrics(&buf, samples); err != nil {
+		t.Fatalf("WriteOpenMetrics() error = %v", err)
+	}
+	want := `# HELP reamaze_tag_conversations Conversations per tag.
+# TYPE reamaze_tag_conversations gauge
+reamaze_tag_conversations{tag="say \"hi\"\\\n"} 2
+reamaze_tag_conversations{tag="vip"} 0.5
+# TYPE dummy gauge
+dummy 1e+21
+# EOF
+`
+	if buf.String() != want {
+		t.Errorf("WriteOpenMetrics() = %s, want %s", buf.String(), want)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var pollErr error
+	h := NewHandler(newMock(t, &pollErr))
+	scrape := func(method string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(method, "/metrics", nil))
+		return rec
+	}
+
+	rec := scrape(http.MethodGet)
+	if body := rec.Body.String(); !strings.HasSuffix(body, "reamaze_reports_up 0\n# EOF\n") || strings.Contains(body, MetricConversations) {
+		t.Errorf("metrics before the first poll = %s", body)
+	}
+
+	if err := h.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+	rec = scrape(http.MethodGet)
+	if got := rec.Header().Get("Content-Type"); got != ContentType {
+		t.Errorf("Content-Type = %q, want %q", got, ContentType)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		`reamaze_conversations{date="2024-01-02"} 5`,
+		`reamaze_channel_staff_responses{channel="support",brand="Shop"} 12`,
+		`reamaze_channel_staff_responses{channel="chat",brand="Shop \"EU\""} 0`,
+		"reamaze_reports_up 1\n",
+		"reamaze_reports_last_success_timestamp_seconds ",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics = %s, want %s", body, want)
+		}
+	}
+
+	// the reports of the last successful poll are still served
+	pollErr = errors.New("dummy")
+	if err := h.Poll(context.Background()); err == nil {
+		t.Errorf("Poll() error = nil, want error")
+	}
+	body = scrape(http.MethodGet).Body.String()
+	if !strings.Contains(body, `reamaze_conversations{date="2024-01-02"} 5`) || !strings.Contains(body, "reamaze_reports_up 0\n") {
+		t.Errorf("metrics after failed poll = %s", body)
+	}
+
+	if rec := scrape(http.MethodPost); rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandler_Run(t *testing.T) {
+	pollErr := errors.New("dummy")
+	var errs []error
+	h := NewHandler(newMock(t, &pollErr), WithInterval(time.Millisecond), WithErrorFunc(func(err error) { errs = append(errs, err) }))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := h.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], pollErr) {
+		t.Errorf("errors = %v, want %v", errs, pollErr)
+	}
+}
+
+func TestHandler_PollMovingRange(t *testing.T) {
+	var pollErr error
+	var dates []string
+	src := newMock(t, &pollErr)
+	volume := src.GetReportsVolumeWithContextFunc
+	src.GetReportsVolumeWithContextFunc = func(ctx context.Context, o ...reamaze.ReportsOption) (*reamaze.GetReportsVolumeResponse, error) {
+		var options reamaze.ReamazeReportOptions
+		for _, opt := range o {
+			opt.Apply(&options)
+		}
+		dates = append(dates, options.Get("brand")+" "+options.Get("start_date")+" "+options.Get("end_date"))
+		return volume(ctx, o...)
+	}
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	h := NewHandler(src, WithReportsOptions(reamaze.WithReportsBrand("shop")), WithReportsOptionsFunc(func() []reamaze.ReportsOption {
+		return []reamaze.ReportsOption{reamaze.WithReportsRange(now.AddDate(0, 0, -6), now)}
+	}))
+	for i := 0; i < 2; i++ {
+		if err := h.Poll(context.Background()); err != nil {
+			t.Fatalf("Poll() error = %v", err)
+		}
+		now = now.AddDate(0, 0, 1)
+	}
+	if want := []string{"shop 2024-01-04 2024-01-10", "shop 2024-01-05 2024-01-11"}; !reflect.DeepEqual(dates, want) {
+		t.Errorf("polled ranges = %v, want %v", dates, want)
+	}
+}
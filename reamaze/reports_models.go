@@ -1,32 +1,33 @@
 package reamaze
 
-import (
-	"fmt"
-	"strings"
-	"time"
-)
+import "time"
 
 const reportsEndpoint string = "/api/v1/reports"
 
+// maxReportsRangeYears is the longest range re:amaze accepts for a single report
+const maxReportsRangeYears = 1
+
 type ReamazeReportsStartDate time.Time
 type ReamazeReportsEndDate time.Time
+type ReamazeReportsBrand string
+type ReamazeReportsChannel string
+
+// ReamazeReportsRange is the date range of a report, see WithReportsRange
+type ReamazeReportsRange struct {
+	Start time.Time
+	End   time.Time
+}
 
 type ReportsOption interface {
 	Apply(*ReamazeReportOptions)
 }
 
 func (w ReamazeReportsEndDate) Apply(o *ReamazeReportOptions) {
-	date := time.Time(w)
-	if date.Year() > 1 && date.Month() > 0 && date.Day() > 0 {
-		o.ReamazeEndDate = "end_date=" + fmt.Sprintf("%04d", date.Year()) + "-" + fmt.Sprintf("%02d", date.Month()) + "-" + fmt.Sprintf("%02d", date.Day())
-	}
+	o.setDate("end_date", time.Time(w))
 }
 
 func (w ReamazeReportsStartDate) Apply(o *ReamazeReportOptions) {
-	date := time.Time(w)
-	if date.Year() > 1 && date.Month() > 0 && date.Day() > 0 {
-		o.ReamazeStartDate = "start_date=" + fmt.Sprintf("%04d", date.Year()) + "-" + fmt.Sprintf("%02d", date.Month()) + "-" + fmt.Sprintf("%02d", date.Day())
-	}
+	o.setDate("start_date", time.Time(w))
 }
 
 func WithReportsEndDate(year int, month int, day int) ReamazeReportsEndDate {
@@ -45,35 +46,121 @@ func WithReportsStartDate(year, month, day int) ReamazeReportsStartDate {
 	}
 	return startDate
 }
-func (r ReamazeReportOptions) GetQuery() string {
-	output := ""
-	var queryParams []string
-	// checking if start_date is set
-	if len(r.ReamazeStartDate) > 0 {
-		queryParams = append(queryParams, r.ReamazeStartDate)
-	}
-	// checking if end_date is set
-	if len(r.ReamazeStartDate) > 0 {
-		queryParams = append(queryParams, r.ReamazeEndDate)
+func (w ReamazeReportsRange) Apply(o *ReamazeReportOptions) {
+	o.setDate("start_date", w.Start)
+	o.setDate("end_date", w.End)
+}
+
+func (w ReamazeReportsBrand) Apply(o *ReamazeReportOptions) {
+	if len(w) > 0 {
+		o.set("brand", string(w))
 	}
+}
 
-	output = strings.Join(queryParams, "&")
-	if len(output) > 0 {
-		output = "?" + output
+func (w ReamazeReportsChannel) Apply(o *ReamazeReportOptions) {
+	if len(w) > 0 {
+		o.set("category", string(w))
 	}
-	return output
 }
+
+// WithReportsRange sets the first and the last day of the report, only the dates of start and end (in their location) are used.
+// Ranges longer than a year, up to today when end is zero, are split into several requests by GetReportsVolume and GetReportsTags, other reports are limited to a year by re:amaze.
+func WithReportsRange(start, end time.Time) ReamazeReportsRange {
+	return ReamazeReportsRange{Start: start, End: end}
+}
+
+// WithReportsLast7Days sets the report range to the last 7 days, today included
+func WithReportsLast7Days() ReamazeReportsRange {
+	return lastDaysRange(time.Now(), 7)
+}
+
+// WithReportsLast30Days sets the report range to the last 30 days, today included
+func WithReportsLast30Days() ReamazeReportsRange {
+	return lastDaysRange(time.Now(), 30)
+}
+
+// WithReportsThisMonth sets the report range from the first day of the current month to today
+func WithReportsThisMonth() ReamazeReportsRange {
+	return thisMonthRange(time.Now())
+}
+
+// WithReportsPreviousQuarter sets the report range to the whole previous calendar quarter
+func WithReportsPreviousQuarter() ReamazeReportsRange {
+	return previousQuarterRange(time.Now())
+}
+
+// WithReportsBrand limits the report to the brand with given name, not every report supports it, see re:amaze API documentation of the report
+func WithReportsBrand(brand string) ReamazeReportsBrand {
+	return ReamazeReportsBrand(brand)
+}
+
+// WithReportsChannel limits the report to the channel with given slug, not every report supports it, see re:amaze API documentation of the report
+func WithReportsChannel(slug string) ReamazeReportsChannel {
+	return ReamazeReportsChannel(slug)
+}
+
+func lastDaysRange(now time.Time, days int) ReamazeReportsRange {
+	today := truncateDay(now)
+	return ReamazeReportsRange{Start: today.AddDate(0, 0, 1-days), End: today}
+}
+
+func thisMonthRange(now time.Time) ReamazeReportsRange {
+	today := truncateDay(now)
+	return ReamazeReportsRange{Start: today.AddDate(0, 0, 1-today.Day()), End: today}
+}
+
+func previousQuarterRange(now time.Time) ReamazeReportsRange {
+	firstMonth := time.Month((int(now.Month())-1)/3*3 + 1)
+	quarter := time.Date(now.Year(), firstMonth, 1, 0, 0, 0, 0, now.Location())
+	return ReamazeReportsRange{Start: quarter.AddDate(0, -3, 0), End: quarter.AddDate(0, 0, -1)}
+}
+
+// truncateDay returns midnight of t's day in t's location
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
 func newReportsSettings(opts []ReportsOption) (*ReamazeReportOptions, error) {
 	var o ReamazeReportOptions
 	for _, opt := range opts {
 		opt.Apply(&o)
 	}
+	if err := o.Err(); err != nil {
+		return nil, err
+	}
 	return &o, nil
 }
 
+// ReamazeReportOptions are the query parameters of reports set by ReportsOption
 type ReamazeReportOptions struct {
-	ReamazeStartDate string
-	ReamazeEndDate   string
+	ReamazeQuery
+}
+
+// windows returns options of requests covering the report range, the range is split into consecutive windows
+// of at most a year when the start date is set and the end date, or today (UTC) when it's not set, is further apart
+func (r ReamazeReportOptions) windows(now time.Time) []ReamazeReportOptions {
+	start, errStart := time.Parse(reamazeDateLayout, r.Get("start_date"))
+	end, errEnd := time.Parse(reamazeDateLayout, r.Get("end_date"))
+	// re:amaze reports up to today when there's no end date
+	if len(r.Get("end_date")) == 0 {
+		end, errEnd = truncateDay(now.UTC()), nil
+	}
+	// checking if the range is set and longer than a year
+	if errStart != nil || errEnd != nil || !end.After(start.AddDate(maxReportsRangeYears, 0, -1)) {
+		return []ReamazeReportOptions{r}
+	}
+	var windows []ReamazeReportOptions
+	for !start.After(end) {
+		windowEnd := start.AddDate(maxReportsRangeYears, 0, -1)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+		window := ReamazeReportOptions{ReamazeQuery: r.clone()}
+		ReamazeReportsRange{Start: start, End: windowEnd}.Apply(&window)
+		windows = append(windows, window)
+		start = windowEnd.AddDate(0, 0, 1)
+	}
+	return windows
 }
 
 type GetReportsVolumeResponse struct {
@@ -110,20 +197,29 @@ type GetReportsResponseTimeRespone struct {
 	EndDate   string `json:"end_date,omitempty"`
 }
 
+// StaffReportMetrics are the metrics of a single staff user in GetReportsStaffResponse
+type StaffReportMetrics struct {
+	ResponseCount int `json:"response_count,omitempty"`
+	ArchivedCount int `json:"archived_count,omitempty"`
+	ResolvedCount int `json:"resolved_count,omitempty"`
+	// SatisfactionAverage is nil when the staff user has no satisfaction ratings in the report range
+	SatisfactionAverage *float64       `json:"satisfaction_average,omitempty"`
+	AppreciationsCount  int            `json:"appreciations_count,omitempty"`
+	ResponsesTrend      map[string]int `json:"responses_trend,omitempty"`
+	ResponseTimeSeconds float64        `json:"response_time_seconds,omitempty"`
+}
+
+// GetReportsStaffResponse is the staff report, Report is keyed by the staff user name (or email when the name isn't set)
 type GetReportsStaffResponse struct {
-	Report struct {
-		RelacjeHiLIVE struct {
-			ResponseCount       int            `json:"response_count,omitempty"`
-			ArchivedCount       int            `json:"archived_count,omitempty"`
-			ResolvedCount       int            `json:"resolved_count,omitempty"`
-			SatisfactionAverage any            `json:"satisfaction_average,omitempty"`
-			AppreciationsCount  any            `json:"appreciations_count,omitempty"`
-			ResponsesTrend      map[string]int `json:"responses_trend,omitempty"`
-			ResponseTimeSeconds float64        `json:"response_time_seconds,omitempty"`
-		} `json:"Relacje HiLIVE,omitempty"`
-	} `json:"report,omitempty"`
-	StartDate string `json:"start_date,omitempty"`
-	EndDate   string `json:"end_date,omitempty"`
+	Report    map[string]StaffReportMetrics `json:"report,omitempty"`
+	StartDate string                        `json:"start_date,omitempty"`
+	EndDate   string                        `json:"end_date,omitempty"`
+}
+
+// StaffRank is a single staff user of the staff report returned by GetReportsStaffResponse ranking methods
+type StaffRank struct {
+	Staff string `json:"staff"`
+	StaffReportMetrics
 }
 
 type GetReportsTagsResponse struct {
@@ -0,0 +1,176 @@
+package reamaze
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ReportPoint is a single value of a report time series
+type ReportPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// ReportSeries is a report time series sorted by time, see GetReportsVolumeResponse.Series
+type ReportSeries []ReportPoint
+
+// ReportBucket is the period points of ReportSeries are aggregated to
+type ReportBucket int
+
+const (
+	ReportBucketDay ReportBucket = iota
+	// ReportBucketWeek buckets start on Monday
+	ReportBucketWeek
+	ReportBucketMonth
+)
+
+// Range returns the range of the report as time.Time, dates are in UTC
+func (r *GetReportsVolumeResponse) Range() (ReamazeReportsRange, error) {
+	return parseReportsRange(r.StartDate, r.EndDate)
+}
+
+// Series returns daily conversation counts, days of the report range without conversations are included with zero
+func (r *GetReportsVolumeResponse) Series() (ReportSeries, error) {
+	return newReportSeries(r.ConversationCounts, r.StartDate, r.EndDate)
+}
+
+// Range returns the range of the report as time.Time, dates are in UTC
+func (r *GetReportsResponseTimeRespone) Range() (ReamazeReportsRange, error) {
+	return parseReportsRange(r.StartDate, r.EndDate)
+}
+
+// Series returns daily response times in seconds, days of the report range without responses are included with zero
+func (r *GetReportsResponseTimeRespone) Series() (ReportSeries, error) {
+	return newReportSeries(r.ResponseTimes, r.StartDate, r.EndDate)
+}
+
+// Range returns the range of the report as time.Time, dates are in UTC
+func (r *GetReportsStaffResponse) Range() (ReamazeReportsRange, error) {
+	return parseReportsRange(r.StartDate, r.EndDate)
+}
+
+// ResponsesTrend returns daily response counts of given staff user, days of the report range without responses are included with zero
+func (r *GetReportsStaffResponse) ResponsesTrend(staff string) (ReportSeries, error) {
+	metrics, ok := r.Report[staff]
+	if !ok {
+		return nil, fmt.Errorf("ResponsesTrend staff %q not found in the report", staff)
+	}
+	return newReportSeries(metrics.ResponsesTrend, r.StartDate, r.EndDate)
+}
+
+// Sum returns series with values of points in the same bucket added up, e.g. conversations per week
+func (s ReportSeries) Sum(bucket ReportBucket) ReportSeries {
+	return s.aggregate(bucket, func(values []float64) float64 {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum
+	})
+}
+
+// Average returns series with the average value of points in the same bucket, e.g. response time per month.
+// Zero values stand for days without data and are left out, a bucket with zero values only is zero.
+func (s ReportSeries) Average(bucket ReportBucket) ReportSeries {
+	return s.aggregate(bucket, func(values []float64) float64 {
+		var sum float64
+		var n int
+		for _, v := range values {
+			if v != 0 {
+				sum += v
+				n++
+			}
+		}
+		if n == 0 {
+			return 0
+		}
+		return sum / float64(n)
+	})
+}
+
+// aggregate groups consecutive points of s by bucket and reduces their values with reduce
+func (s ReportSeries) aggregate(bucket ReportBucket, reduce func(values []float64) float64) ReportSeries {
+	aggregated := ReportSeries{}
+	var values []float64
+	for i, p := range s {
+		values = append(values, p.Value)
+		start := bucket.start(p.Time)
+		if i == len(s)-1 || !bucket.start(s[i+1].Time).Equal(start) {
+			aggregated = append(aggregated, ReportPoint{Time: start, Value: reduce(values)})
+			values = values[:0]
+		}
+	}
+	return aggregated
+}
+
+// start returns the beginning of the bucket t falls into
+func (b ReportBucket) start(t time.Time) time.Time {
+	day := truncateDay(t)
+	switch b {
+	case ReportBucketWeek:
+		// time.Sunday is 0, weeks start on Monday
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case ReportBucketMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+// newReportSeries parses date keys of values into a series sorted by time. Days between start and end
+// (or the first and the last key when they're empty) missing in values are filled with zero.
+func newReportSeries[T int | float64](values map[string]T, start, end string) (ReportSeries, error) {
+	series := make(ReportSeries, 0, len(values))
+	days := make(map[time.Time]bool, len(values))
+	for key, value := range values {
+		day, err := time.Parse(reamazeDateLayout, key)
+		if err != nil {
+			return nil, fmt.Errorf("report date %q: %w", key, err)
+		}
+		series = append(series, ReportPoint{Time: day, Value: float64(value)})
+		days[day] = true
+	}
+	slices.SortFunc(series, func(a, b ReportPoint) int { return a.Time.Compare(b.Time) })
+
+	reportRange, err := parseReportsRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	// falling back to the dates of the data when the report range isn't known
+	if len(series) > 0 {
+		if reportRange.Start.IsZero() {
+			reportRange.Start = series[0].Time
+		}
+		if reportRange.End.IsZero() {
+			reportRange.End = series[len(series)-1].Time
+		}
+	}
+	if reportRange.Start.IsZero() || reportRange.End.IsZero() {
+		return series, nil
+	}
+	for day := reportRange.Start; !day.After(reportRange.End); day = day.AddDate(0, 0, 1) {
+		if !days[day] {
+			series = append(series, ReportPoint{Time: day})
+		}
+	}
+	slices.SortFunc(series, func(a, b ReportPoint) int { return a.Time.Compare(b.Time) })
+	return series, nil
+}
+
+// parseReportsRange parses start_date and end_date of a report response, empty dates are left zero
+func parseReportsRange(start, end string) (ReamazeReportsRange, error) {
+	var r ReamazeReportsRange
+	var err error
+	if len(start) > 0 {
+		if r.Start, err = time.Parse(reamazeDateLayout, start); err != nil {
+			return ReamazeReportsRange{}, fmt.Errorf("report start date %q: %w", start, err)
+		}
+	}
+	if len(end) > 0 {
+		if r.End, err = time.Parse(reamazeDateLayout, end); err != nil {
+			return ReamazeReportsRange{}, fmt.Errorf("report end date %q: %w", end, err)
+		}
+	}
+	return r, nil
+}
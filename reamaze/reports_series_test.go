@@ -0,0 +1,143 @@
+package reamaze
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func reportDay(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGetReportsVolumeResponse_Series(t *testing.T) {
+	tests := []struct {
+		name    string
+		report  *GetReportsVolumeResponse
+		want    ReportSeries
+		wantErr bool
+	}{
+		{
+			name: "Testing filling missing days of the report range",
+			report: &GetReportsVolumeResponse{
+				ConversationCounts: map[string]int{"2024-01-04": 2, "2024-01-02": 5},
+				StartDate:          "2024-01-01",
+				EndDate:            "2024-01-04",
+			},
+			want: ReportSeries{{reportDay(2024, 1, 1), 0}, {reportDay(2024, 1, 2), 5}, {reportDay(2024, 1, 3), 0}, {reportDay(2024, 1, 4), 2}},
+		},
+		{
+			name:   "Testing report without range",
+			report: &GetReportsVolumeResponse{ConversationCounts: map[string]int{"2024-01-03": 1, "2024-01-01": 3}},
+			want:   ReportSeries{{reportDay(2024, 1, 1), 3}, {reportDay(2024, 1, 2), 0}, {reportDay(2024, 1, 3), 1}},
+		},
+		{
+			name:   "Testing empty report",
+			report: &GetReportsVolumeResponse{},
+			want:   ReportSeries{},
+		},
+		{
+			name:    "Testing invalid date key",
+			report:  &GetReportsVolumeResponse{ConversationCounts: map[string]int{"01/02/2024": 1}},
+			wantErr: true,
+		},
+		{
+			name:    "Testing invalid report range",
+			report:  &GetReportsVolumeResponse{StartDate: "dummy"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.report.Series()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Series() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Series() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReportSeries_Aggregate(t *testing.T) {
+	// 2024-01-01 is Monday
+	series := ReportSeries{
+		{reportDay(2024, 1, 6), 2}, {reportDay(2024, 1, 7), 0}, {reportDay(2024, 1, 8), 4},
+		{reportDay(2024, 1, 31), 6}, {reportDay(2024, 2, 1), 1},
+	}
+	tests := []struct {
+		name string
+		got  ReportSeries
+		want ReportSeries
+	}{
+		{
+			name: "Testing daily sum",
+			got:  series.Sum(ReportBucketDay),
+			want: series,
+		},
+		{
+			name: "Testing weekly sum",
+			got:  series.Sum(ReportBucketWeek),
+			want: ReportSeries{{reportDay(2024, 1, 1), 2}, {reportDay(2024, 1, 8), 4}, {reportDay(2024, 1, 29), 7}},
+		},
+		{
+			name: "Testing monthly sum",
+			got:  series.Sum(ReportBucketMonth),
+			want: ReportSeries{{reportDay(2024, 1, 1), 12}, {reportDay(2024, 2, 1), 1}},
+		},
+		{
+			name: "Testing monthly average skipping days without data",
+			got:  series.Average(ReportBucketMonth),
+			want: ReportSeries{{reportDay(2024, 1, 1), 4}, {reportDay(2024, 2, 1), 1}},
+		},
+		{
+			name: "Testing average of days without data",
+			got:  ReportSeries{{reportDay(2024, 1, 1), 0}}.Average(ReportBucketWeek),
+			want: ReportSeries{{reportDay(2024, 1, 1), 0}},
+		},
+		{
+			name: "Testing empty series",
+			got:  ReportSeries{}.Sum(ReportBucketWeek),
+			want: ReportSeries{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("aggregated = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReportsSeriesAccessors(t *testing.T) {
+	responseTime := &GetReportsResponseTimeRespone{ResponseTimes: map[string]float64{"2024-01-02": 90.5}, StartDate: "2024-01-01", EndDate: "2024-01-02"}
+	got, err := responseTime.Series()
+	if want := (ReportSeries{{reportDay(2024, 1, 1), 0}, {reportDay(2024, 1, 2), 90.5}}); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetReportsResponseTimeRespone.Series() = %v, %v, want %v", got, err, want)
+	}
+	r, err := responseTime.Range()
+	if err != nil || !r.Start.Equal(reportDay(2024, 1, 1)) || !r.End.Equal(reportDay(2024, 1, 2)) {
+		t.Errorf("GetReportsResponseTimeRespone.Range() = %v, %v", r, err)
+	}
+
+	staff := &GetReportsStaffResponse{
+		Report:    map[string]StaffReportMetrics{"Jane": {ResponsesTrend: map[string]int{"2024-01-01": 3}}},
+		StartDate: "2024-01-01",
+		EndDate:   "2024-01-02",
+	}
+	got, err = staff.ResponsesTrend("Jane")
+	if want := (ReportSeries{{reportDay(2024, 1, 1), 3}, {reportDay(2024, 1, 2), 0}}); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetReportsStaffResponse.ResponsesTrend() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := staff.ResponsesTrend("dummy"); err == nil {
+		t.Errorf("GetReportsStaffResponse.ResponsesTrend() of unknown staff error = nil")
+	}
+	if r, err := staff.Range(); err != nil || !r.End.Equal(reportDay(2024, 1, 2)) {
+		t.Errorf("GetReportsStaffResponse.Range() = %v, %v", r, err)
+	}
+	if r, err := (&GetReportsVolumeResponse{}).Range(); err != nil || !r.Start.IsZero() || !r.End.IsZero() {
+		t.Errorf("GetReportsVolumeResponse.Range() = %v, %v", r, err)
+	}
+}
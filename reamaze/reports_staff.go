@@ -0,0 +1,51 @@
+package reamaze
+
+import (
+	"cmp"
+	"slices"
+)
+
+// RankByResponseCount returns staff users ordered by the number of responses in the report range, most responses first
+func (r *GetReportsStaffResponse) RankByResponseCount() []StaffRank {
+	return r.rank(nil, func(a, b StaffRank) int {
+		return cmp.Compare(b.ResponseCount, a.ResponseCount)
+	})
+}
+
+// RankByResolvedCount returns staff users ordered by the number of conversations resolved in the report range, most resolved first
+func (r *GetReportsStaffResponse) RankByResolvedCount() []StaffRank {
+	return r.rank(nil, func(a, b StaffRank) int {
+		return cmp.Compare(b.ResolvedCount, a.ResolvedCount)
+	})
+}
+
+// RankByResponseTime returns staff users ordered by their response time in the report range, fastest first.
+// Staff users without any responses have no response time and are left out.
+func (r *GetReportsStaffResponse) RankByResponseTime() []StaffRank {
+	hasResponses := func(s StaffRank) bool { return s.ResponseCount > 0 }
+	return r.rank(hasResponses, func(a, b StaffRank) int {
+		return cmp.Compare(a.ResponseTimeSeconds, b.ResponseTimeSeconds)
+	})
+}
+
+// rank returns staff users for which keep returns true (all when keep is nil) sorted with compare,
+// staff users ranked equally are sorted by name so that the order is stable between calls
+func (r *GetReportsStaffResponse) rank(keep func(StaffRank) bool, compare func(a, b StaffRank) int) []StaffRank {
+	if r == nil {
+		return nil
+	}
+	ranking := make([]StaffRank, 0, len(r.Report))
+	for staff, metrics := range r.Report {
+		s := StaffRank{Staff: staff, StaffReportMetrics: metrics}
+		if keep == nil || keep(s) {
+			ranking = append(ranking, s)
+		}
+	}
+	slices.SortFunc(ranking, func(a, b StaffRank) int {
+		if c := compare(a, b); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Staff, b.Staff)
+	})
+	return ranking
+}
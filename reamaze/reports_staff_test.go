@@ -0,0 +1,68 @@
+package reamaze
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetReportsStaffResponse_Rank(t *testing.T) {
+	report := &GetReportsStaffResponse{Report: map[string]StaffReportMetrics{
+		"Jane":             {ResponseCount: 12, ResolvedCount: 3, ResponseTimeSeconds: 600},
+		"John":             {ResponseCount: 30, ResolvedCount: 9, ResponseTimeSeconds: 900},
+		"Anna":             {ResponseCount: 12, ResolvedCount: 9, ResponseTimeSeconds: 120},
+		"mark@example.com": {},
+	}}
+	staff := func(ranking []StaffRank) []string {
+		names := []string{}
+		for _, s := range ranking {
+			names = append(names, s.Staff)
+		}
+		return names
+	}
+	tests := []struct {
+		name   string
+		report *GetReportsStaffResponse
+		rank   func(r *GetReportsStaffResponse) []StaffRank
+		want   []string
+	}{
+		{
+			name:   "Testing ranking by response count",
+			report: report,
+			rank:   (*GetReportsStaffResponse).RankByResponseCount,
+			want:   []string{"John", "Anna", "Jane", "mark@example.com"},
+		},
+		{
+			name:   "Testing ranking by resolved count",
+			report: report,
+			rank:   (*GetReportsStaffResponse).RankByResolvedCount,
+			want:   []string{"Anna", "John", "Jane", "mark@example.com"},
+		},
+		{
+			name:   "Testing ranking by response time",
+			report: report,
+			rank:   (*GetReportsStaffResponse).RankByResponseTime,
+			want:   []string{"Anna", "Jane", "John"},
+		},
+		{
+			name:   "Testing empty report",
+			report: &GetReportsStaffResponse{},
+			rank:   (*GetReportsStaffResponse).RankByResponseCount,
+			want:   []string{},
+		},
+		{
+			name: "Testing nil report",
+			rank: (*GetReportsStaffResponse).RankByResolvedCount,
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := staff(tt.rank(tt.report)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ranking = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := report.RankByResponseCount()[0]; got.ResolvedCount != 9 || got.ResponseTimeSeconds != 900 {
+		t.Errorf("RankByResponseCount()[0] = %+v, want metrics of John", got)
+	}
+}
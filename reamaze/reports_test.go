@@ -3,6 +3,7 @@ package reamaze
 import (
 	"io"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
@@ -202,6 +203,32 @@ func TestClient_GetReportsStaff(t *testing.T) {
 			want:    &GetReportsStaffResponse{},
 			wantErr: false,
 		},
+		{
+			name: "Testing staff report",
+			fields: fields{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Status:     "200 Status OK",
+						Body: io.NopCloser(strings.NewReader(`{"report":{` +
+							`"Jane Doe":{"response_count":12,"archived_count":1,"resolved_count":8,"satisfaction_average":4.5,"appreciations_count":2,"responses_trend":{"2024-01-01":12},"response_time_seconds":300.5},` +
+							`"john@example.com":{"response_count":0,"archived_count":0,"resolved_count":0,"satisfaction_average":null,"appreciations_count":null,"responses_trend":{},"response_time_seconds":0}},` +
+							`"start_date":"2024-01-01","end_date":"2024-01-31"}`)),
+					}
+				}),
+			}},
+			args: args{},
+			want: &GetReportsStaffResponse{
+				Report: map[string]StaffReportMetrics{
+					"Jane Doe": {ResponseCount: 12, ArchivedCount: 1, ResolvedCount: 8, SatisfactionAverage: Ptr(4.5), AppreciationsCount: 2,
+						ResponsesTrend: map[string]int{"2024-01-01": 12}, ResponseTimeSeconds: 300.5},
+					"john@example.com": {ResponsesTrend: map[string]int{}},
+				},
+				StartDate: "2024-01-01",
+				EndDate:   "2024-01-31",
+			},
+			wantErr: false,
+		},
 		{
 			name: "Testing incorrect JSON response",
 			fields: fields{baseURL: "https://dummy.reamaze.io", auth: "dummy", httpClient: &http.Client{
@@ -486,26 +513,26 @@ func TestReamazeReportsStartDate_Apply(t *testing.T) {
 		name string
 		w    ReamazeReportsStartDate
 		args args
-		want *ReamazeReportOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeStartDate is  being set in ReamazeReportOptions",
 			w:    ReamazeReportsStartDate(time.Date(2024, time.Month(1), 1, 0, 0, 0, 0, time.UTC)),
 			args: args{o: &ReamazeReportOptions{}},
-			want: &ReamazeReportOptions{ReamazeStartDate: "start_date=2024-01-01"},
+			want: "?start_date=2024-01-01",
 		},
 		{
 			name: "Testing if ReamazeStartDate is not being set in ReamazeReportOptions if date is empty",
 			w:    ReamazeReportsStartDate{},
 			args: args{o: &ReamazeReportOptions{}},
-			want: &ReamazeReportOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeReportsStartDate.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -519,26 +546,26 @@ func TestReamazeReportsEndDate_Apply(t *testing.T) {
 		name string
 		w    ReamazeReportsEndDate
 		args args
-		want *ReamazeReportOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazeReportsEndDate is  being set in ReamazeReportOptions",
 			w:    ReamazeReportsEndDate(time.Date(2024, time.Month(1), 1, 0, 0, 0, 0, time.UTC)),
 			args: args{o: &ReamazeReportOptions{}},
-			want: &ReamazeReportOptions{ReamazeEndDate: "end_date=2024-01-01"},
+			want: "?end_date=2024-01-01",
 		},
 		{
 			name: "Testing if ReamazeEndDate is not being set in ReamazeReportOptions if date is empty",
 			w:    ReamazeReportsEndDate{},
 			args: args{o: &ReamazeReportOptions{}},
-			want: &ReamazeReportOptions{},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazeReportsEndDate.Apply() = %v, want %v", got, tt.want)
 			}
 		})
@@ -552,19 +579,35 @@ func Test_newReportsSettings(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    *ReamazeReportOptions
+		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Testing newReportsSettings expansion when no arguments provided",
 			args:    args{},
-			want:    &ReamazeReportOptions{},
+			want:    "",
 			wantErr: false,
 		},
 		{
 			name:    "Testing newReportsSettings WithFilter Option ReamazeFilterAll",
 			args:    args{opts: []ReportsOption{WithReportsStartDate(2024, 1, 1)}},
-			want:    &ReamazeReportOptions{ReamazeStartDate: string("start_date=2024-01-01")},
+			want:    "?start_date=2024-01-01",
+			wantErr: false,
+		},
+		{
+			name: "Testing newReportsSettings with range and filters",
+			args: args{opts: []ReportsOption{
+				WithReportsRange(time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+				WithReportsBrand("My Brand"),
+				WithReportsChannel("support"),
+			}},
+			want:    "?brand=My+Brand&category=support&end_date=2024-01-31&start_date=2024-01-01",
+			wantErr: false,
+		},
+		{
+			name:    "Testing newReportsSettings with empty range and filters",
+			args:    args{opts: []ReportsOption{WithReportsRange(time.Time{}, time.Time{}), WithReportsBrand(""), WithReportsChannel("")}},
+			want:    "",
 			wantErr: false,
 		},
 	}
@@ -575,42 +618,39 @@ func Test_newReportsSettings(t *testing.T) {
 				t.Errorf("newReportsSettings() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("newReportsSettings() = %v, want %v", got, tt.want)
+			if err == nil && got.GetQuery() != tt.want {
+				t.Errorf("newReportsSettings() = %v, want %v", got.GetQuery(), tt.want)
 			}
 		})
 	}
 }
 
 func TestReamazeReportOptions_GetQuery(t *testing.T) {
-	type fields struct {
-		ReamazeStartDate string
-		ReamazeEndDate   string
-	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   string
+		name string
+		opts []ReportsOption
+		want string
 	}{
 		{
-			name:   "Testing ReamazeReportOptions.GetQuery no fields set",
-			fields: fields{},
-			want:   "",
+			name: "Testing ReamazeReportOptions.GetQuery no options set",
+			want: "",
 		},
 		{
-			name: "Testing ReamazeReportOptions.GetQuery with all fields set to dummy",
-			fields: fields{
-				ReamazeStartDate: "dummy",
-				ReamazeEndDate:   "dummy",
-			},
-			want: "?dummy&dummy",
+			name: "Testing ReamazeReportOptions.GetQuery with filters",
+			opts: []ReportsOption{WithReportsStartDate(2024, 1, 1), WithReportsEndDate(2024, 1, 31), WithReportsBrand("dummy"), WithReportsChannel("dummy")},
+			want: "?brand=dummy&category=dummy&end_date=2024-01-31&start_date=2024-01-01",
+		},
+		{
+			name: "Testing ReamazeReportOptions.GetQuery with end date only",
+			opts: []ReportsOption{WithReportsEndDate(2024, 1, 31)},
+			want: "?end_date=2024-01-31",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := ReamazeReportOptions{
-				ReamazeStartDate: tt.fields.ReamazeStartDate,
-				ReamazeEndDate:   tt.fields.ReamazeEndDate,
+			var r ReamazeReportOptions
+			for _, opt := range tt.opts {
+				opt.Apply(&r)
 			}
 			if got := r.GetQuery(); got != tt.want {
 				t.Errorf("ReamazeReportOptions.GetQuery() = %v, want %v", got, tt.want)
@@ -618,3 +658,139 @@ func TestReamazeReportOptions_GetQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestReportsPresets(t *testing.T) {
+	day := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	now := time.Date(2024, 2, 15, 13, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		got  ReamazeReportsRange
+		want ReamazeReportsRange
+	}{
+		{name: "Testing last 7 days", got: lastDaysRange(now, 7), want: ReamazeReportsRange{Start: day(2024, 2, 9), End: day(2024, 2, 15)}},
+		{name: "Testing last 30 days", got: lastDaysRange(now, 30), want: ReamazeReportsRange{Start: day(2024, 1, 17), End: day(2024, 2, 15)}},
+		{name: "Testing this month", got: thisMonthRange(now), want: ReamazeReportsRange{Start: day(2024, 2, 1), End: day(2024, 2, 15)}},
+		{name: "Testing previous quarter in the first quarter", got: previousQuarterRange(now), want: ReamazeReportsRange{Start: day(2023, 10, 1), End: day(2023, 12, 31)}},
+		{name: "Testing previous quarter in the last month of a quarter", got: previousQuarterRange(day(2024, 6, 30)), want: ReamazeReportsRange{Start: day(2024, 1, 1), End: day(2024, 3, 31)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Start.Equal(tt.want.Start) || !tt.got.End.Equal(tt.want.End) {
+				t.Errorf("range = %v - %v, want %v - %v", tt.got.Start, tt.got.End, tt.want.Start, tt.want.End)
+			}
+		})
+	}
+}
+
+// reportsWindowsClient returns client answering report requests with body returned by respond for the request query,
+// queries of the requests are recorded in queries
+func reportsWindowsClient(queries *[]string, respond func(query string) string) *Client {
+	return &Client{
+		baseURL: "https://dummy.reamaze.io",
+		auth:    "dummy",
+		httpClient: &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				*queries = append(*queries, req.URL.RawQuery)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Status:     "200 OK",
+					Body:       io.NopCloser(strings.NewReader(respond(req.URL.RawQuery))),
+				}
+			}),
+		},
+	}
+}
+
+func TestClient_GetReportsWindows(t *testing.T) {
+	long := WithReportsRange(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	wantQueries := []string{
+		"brand=dummy&end_date=2022-12-31&start_date=2022-01-01",
+		"brand=dummy&end_date=2023-12-31&start_date=2023-01-01",
+		"brand=dummy&end_date=2024-03-15&start_date=2024-01-01",
+	}
+	// every window reports its first day and a shared tag
+	respond := func(query string) string {
+		values, _ := url.ParseQuery(query)
+		start, end := values.Get("start_date"), values.Get("end_date")
+		return `{"conversation_counts":{"` + start + `":1},"tags":{"` + start + `":1,"shared":2},"start_date":"` + start + `","end_date":"` + end + `"}`
+	}
+
+	t.Run("Testing volume report longer than a year", func(t *testing.T) {
+		var queries []string
+		got, err := reportsWindowsClient(&queries, respond).GetReportsVolume(long, WithReportsBrand("dummy"))
+		if err != nil {
+			t.Fatalf("GetReportsVolume() error = %v", err)
+		}
+		want := &GetReportsVolumeResponse{
+			ConversationCounts: map[string]int{"2022-01-01": 1, "2023-01-01": 1, "2024-01-01": 1},
+			StartDate:          "2022-01-01",
+			EndDate:            "2024-03-15",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetReportsVolume() = %+v, want %+v", got, want)
+		}
+		if !reflect.DeepEqual(queries, wantQueries) {
+			t.Errorf("queries = %v, want %v", queries, wantQueries)
+		}
+	})
+
+	t.Run("Testing tags report longer than a year", func(t *testing.T) {
+		var queries []string
+		got, err := reportsWindowsClient(&queries, respond).GetReportsTags(long, WithReportsBrand("dummy"))
+		if err != nil {
+			t.Fatalf("GetReportsTags() error = %v", err)
+		}
+		want := &GetReportsTagsResponse{
+			Tags:      map[string]int{"2022-01-01": 1, "2023-01-01": 1, "2024-01-01": 1, "shared": 6},
+			StartDate: "2022-01-01",
+			EndDate:   "2024-03-15",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetReportsTags() = %+v, want %+v", got, want)
+		}
+		if !reflect.DeepEqual(queries, wantQueries) {
+			t.Errorf("queries = %v, want %v", queries, wantQueries)
+		}
+	})
+
+	t.Run("Testing report of exactly a year", func(t *testing.T) {
+		var queries []string
+		year := WithReportsRange(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))
+		if _, err := reportsWindowsClient(&queries, respond).GetReportsVolume(year); err != nil {
+			t.Fatalf("GetReportsVolume() error = %v", err)
+		}
+		if want := []string{"end_date=2023-12-31&start_date=2023-01-01"}; !reflect.DeepEqual(queries, want) {
+			t.Errorf("queries = %v, want %v", queries, want)
+		}
+	})
+
+	t.Run("Testing report from a start date over a year ago", func(t *testing.T) {
+		var queries []string
+		today := time.Now().UTC()
+		start := WithReportsRange(today.AddDate(-2, 0, 0), time.Time{})
+		if _, err := reportsWindowsClient(&queries, respond).GetReportsVolume(start); err != nil {
+			t.Fatalf("GetReportsVolume() error = %v", err)
+		}
+		if len(queries) != 3 {
+			t.Fatalf("queries = %v, want 3 windows", queries)
+		}
+		if want := "end_date=" + today.Format("2006-01-02"); !strings.HasPrefix(queries[2], want) {
+			t.Errorf("last query = %v, want %v", queries[2], want)
+		}
+	})
+
+	t.Run("Testing failing window", func(t *testing.T) {
+		var queries []string
+		c := reportsWindowsClient(&queries, func(query string) string {
+			if strings.HasSuffix(query, "start_date=2023-01-01") {
+				return "{"
+			}
+			return respond(query)
+		})
+		if got, err := c.GetReportsTags(long); err == nil {
+			t.Errorf("GetReportsTags() = %+v, want error", got)
+		}
+	})
+}
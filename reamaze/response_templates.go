@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -12,10 +13,15 @@ import (
 // q with any string will search over response templates by keywords.
 // page with any number will allow you to paginate through results
 func (c *Client) GetResponseTemplates() (*GetResponseTemplatesResponse, error) {
+	return c.GetResponseTemplatesWithContext(context.Background())
+}
+
+// GetResponseTemplatesWithContext is like GetResponseTemplates but uses ctx for the underlying request.
+func (c *Client) GetResponseTemplatesWithContext(ctx context.Context) (*GetResponseTemplatesResponse, error) {
 	var response *GetResponseTemplatesResponse
 	urlEndpoint := responseTemplatesEndpoint
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -29,13 +35,18 @@ func (c *Client) GetResponseTemplates() (*GetResponseTemplatesResponse, error) {
 
 // GetResponseTemplate will allow you to retrieve a specific Response Template https://www.reamaze.com/api/get_response_template
 func (c *Client) GetResponseTemplate(identifier string) (*GetResponseTemplateResponse, error) {
+	return c.GetResponseTemplateWithContext(context.Background(), identifier)
+}
+
+// GetResponseTemplateWithContext is like GetResponseTemplate but uses ctx for the underlying request.
+func (c *Client) GetResponseTemplateWithContext(ctx context.Context, identifier string) (*GetResponseTemplateResponse, error) {
 	var response *GetResponseTemplateResponse
 	// checking if identifier is set
 	if len(identifier) == 0 {
 		return nil, errors.New("GetResponseTemplate identifier cannot be empty, please provide identifier as argument")
 	}
 	urlEndpoint := responseTemplatesEndpoint + "/" + identifier
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +60,11 @@ func (c *Client) GetResponseTemplate(identifier string) (*GetResponseTemplateRes
 
 // CreateResponseTemplate will allow you to create a new Response Template https://www.reamaze.com/api/post_response_template
 func (c *Client) CreateResponseTemplate(req *CreateResponseTemplateRequest) (*CreateResponseTemplateResponse, error) {
+	return c.CreateResponseTemplateWithContext(context.Background(), req)
+}
+
+// CreateResponseTemplateWithContext is like CreateResponseTemplate but uses ctx for the underlying request.
+func (c *Client) CreateResponseTemplateWithContext(ctx context.Context, req *CreateResponseTemplateRequest) (*CreateResponseTemplateResponse, error) {
 	var response *CreateResponseTemplateResponse
 	emptyReq := &CreateResponseTemplateRequest{}
 
@@ -61,7 +77,7 @@ func (c *Client) CreateResponseTemplate(req *CreateResponseTemplateRequest) (*Cr
 	// preparing request
 	data, _ := json.Marshal(req)
 
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +91,11 @@ func (c *Client) CreateResponseTemplate(req *CreateResponseTemplateRequest) (*Cr
 
 // UpdateResponseTemplate will allow you to update the response template https://www.reamaze.com/api/put_response_template
 func (c *Client) UpdateResponseTemplate(identifier string, req *UpdateResponseTemplateRequest) (*UpdateResponseTemplateResponse, error) {
+	return c.UpdateResponseTemplateWithContext(context.Background(), identifier, req)
+}
+
+// UpdateResponseTemplateWithContext is like UpdateResponseTemplate but uses ctx for the underlying request.
+func (c *Client) UpdateResponseTemplateWithContext(ctx context.Context, identifier string, req *UpdateResponseTemplateRequest) (*UpdateResponseTemplateResponse, error) {
 	var response *UpdateResponseTemplateResponse
 	emptyReq := &UpdateResponseTemplateRequest{}
 
@@ -91,7 +112,7 @@ func (c *Client) UpdateResponseTemplate(identifier string, req *UpdateResponseTe
 	// preparing request
 	data, _ := json.Marshal(req)
 
-	resp, err := c.reamazeRequest(http.MethodPut, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPut, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
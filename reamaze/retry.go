@@ -0,0 +1,100 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how the Client retries requests which failed with 429, 502, 503, 504 or a network error.
+// GET requests are always retried, POST and PUT requests only when RetryNonIdempotent is set.
+type RetryPolicy struct {
+	MaxAttempts        int           // total number of attempts including the first one
+	MaxElapsed         time.Duration // overall time budget for all attempts, zero means no limit
+	BaseDelay          time.Duration // delay before the first retry, doubled with every next retry
+	MaxDelay           time.Duration // upper bound of a single delay, zero means no limit
+	RetryNonIdempotent bool          // retry POST and PUT requests as well
+}
+
+// DefaultRetryPolicy returns RetryPolicy with 4 attempts within one minute starting with 500ms delay
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MaxElapsed:  time.Minute,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+type ReamazeRetryPolicy RetryPolicy
+
+func (w ReamazeRetryPolicy) Apply(o *ReamazeClientOptions) {
+	if w.MaxAttempts > 1 {
+		policy := RetryPolicy(w)
+		o.RetryPolicy = &policy
+	}
+}
+
+// WithRetryPolicy makes the Client retry failed requests according to the given policy, e.g. WithRetryPolicy(DefaultRetryPolicy())
+func WithRetryPolicy(policy RetryPolicy) ReamazeRetryPolicy {
+	return ReamazeRetryPolicy(policy)
+}
+
+// retryable checks if the request with given method which failed with err may be repeated
+func (p *RetryPolicy) retryable(method string, err error) bool {
+	if method != http.MethodGet && !p.RetryNonIdempotent {
+		return false
+	}
+	// we don't retry when the caller gave up
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+	// anything else is a network error
+	return true
+}
+
+// delay returns how long we should wait before the given retry attempt, attempt starts from 1
+func (p *RetryPolicy) delay(attempt int, err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+	backoff := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if p.MaxDelay > 0 && backoff > p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// adding jitter so that many workers don't retry at the same moment
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package reamaze
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_retryable(t *testing.T) {
+	type args struct {
+		method string
+		err    error
+	}
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		args   args
+		want   bool
+	}{
+		{name: "Testing GET 429", args: args{method: http.MethodGet, err: &APIError{StatusCode: http.StatusTooManyRequests}}, want: true},
+		{name: "Testing GET 503", args: args{method: http.MethodGet, err: &APIError{StatusCode: http.StatusServiceUnavailable}}, want: true},
+		{name: "Testing GET 404", args: args{method: http.MethodGet, err: &APIError{StatusCode: http.StatusNotFound}}, want: false},
+		{name: "Testing GET 500", args: args{method: http.MethodGet, err: &APIError{StatusCode: http.StatusInternalServerError}}, want: false},
+		{name: "Testing GET network error", args: args{method: http.MethodGet, err: errors.New("connection reset")}, want: true},
+		{name: "Testing GET canceled context", args: args{method: http.MethodGet, err: context.Canceled}, want: false},
+		{name: "Testing POST 429 without opt-in", args: args{method: http.MethodPost, err: &APIError{StatusCode: http.StatusTooManyRequests}}, want: false},
+		{name: "Testing POST 429 with opt-in", policy: RetryPolicy{RetryNonIdempotent: true}, args: args{method: http.MethodPost, err: &APIError{StatusCode: http.StatusTooManyRequests}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.retryable(tt.args.method, tt.args.err); got != tt.want {
+				t.Errorf("RetryPolicy.retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		min     time.Duration
+		max     time.Duration
+	}{
+		{name: "Testing first retry", attempt: 1, err: errors.New("dummy"), min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{name: "Testing second retry", attempt: 2, err: errors.New("dummy"), min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		{name: "Testing MaxDelay", attempt: 10, err: errors.New("dummy"), min: 150 * time.Millisecond, max: 300 * time.Millisecond},
+		{name: "Testing Retry-After", attempt: 1, err: &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 5 * time.Second}, min: 5 * time.Second, max: 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.delay(tt.attempt, tt.err); got < tt.min || got > tt.max {
+				t.Errorf("RetryPolicy.delay() = %v, want between %v and %v", got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		want   *ReamazeClientOptions
+	}{
+		{name: "Testing default policy", policy: DefaultRetryPolicy(), want: &ReamazeClientOptions{RetryPolicy: &RetryPolicy{MaxAttempts: 4, MaxElapsed: time.Minute, BaseDelay: 500 * time.Millisecond, MaxDelay: 30 * time.Second}}},
+		{name: "Testing single attempt disables retries", policy: RetryPolicy{MaxAttempts: 1}, want: &ReamazeClientOptions{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &ReamazeClientOptions{}
+			WithRetryPolicy(tt.policy).Apply(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithRetryPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_reamazeRequestRetry(t *testing.T) {
+	newClient := func(policy *RetryPolicy, statusCodes ...int) (*Client, *int) {
+		calls := 0
+		return &Client{baseURL: "https://dummy.reamaze.io", auth: "dummy", retryPolicy: policy, httpClient: &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				statusCode := statusCodes[calls]
+				calls++
+				return &http.Response{
+					StatusCode: statusCode,
+					Status:     http.StatusText(statusCode),
+					Body:       io.NopCloser(strings.NewReader(`{"status":"ok"}`)),
+				}
+			}),
+		}}, &calls
+	}
+	policy := &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	tests := []struct {
+		name        string
+		policy      *RetryPolicy
+		method      string
+		statusCodes []int
+		wantCalls   int
+		wantErr     bool
+	}{
+		{name: "Testing no retry policy", policy: nil, method: http.MethodGet, statusCodes: []int{503, 200}, wantCalls: 1, wantErr: true},
+		{name: "Testing retry succeeds", policy: policy, method: http.MethodGet, statusCodes: []int{429, 503, 200}, wantCalls: 3, wantErr: false},
+		{name: "Testing retry gives up after MaxAttempts", policy: policy, method: http.MethodGet, statusCodes: []int{503, 503, 503, 200}, wantCalls: 3, wantErr: true},
+		{name: "Testing POST is not retried", policy: policy, method: http.MethodPost, statusCodes: []int{503, 200}, wantCalls: 1, wantErr: true},
+		{name: "Testing 404 is not retried", policy: policy, method: http.MethodGet, statusCodes: []int{404, 200}, wantCalls: 1, wantErr: true},
+		{name: "Testing MaxElapsed budget", policy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour, MaxElapsed: time.Second}, method: http.MethodGet, statusCodes: []int{503, 200}, wantCalls: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, calls := newClient(tt.policy, tt.statusCodes...)
+			_, err := c.reamazeRequest(context.Background(), tt.method, "/api/v1/conversations", []byte{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.reamazeRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("Client.reamazeRequest() calls = %v, want %v", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
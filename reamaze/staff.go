@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -9,11 +10,19 @@ import (
 
 // GetStaff will allow you to retrieve staff users for the Account https://www.reamaze.com/api/get_staff
 func (c *Client) GetStaff(o ...StaffOption) (*GetStaffResponse, error) {
+	return c.GetStaffWithContext(context.Background(), o...)
+}
+
+// GetStaffWithContext is like GetStaff but uses ctx for the underlying request.
+func (c *Client) GetStaffWithContext(ctx context.Context, o ...StaffOption) (*GetStaffResponse, error) {
 	var response *GetStaffResponse
-	settings, _ := newStaffSettings(o)
+	settings, err := newStaffSettings(o)
+	if err != nil {
+		return nil, err
+	}
 	urlEndpoint := staffEndpoint + settings.GetQuery()
 
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +38,11 @@ func (c *Client) GetStaff(o ...StaffOption) (*GetStaffResponse, error) {
 // No invite emails are sent to the created user's email. This is to prevent potential abuse.
 // The created user will be asked to change their password the first time they log in.
 func (c *Client) CreateStaff(req *CreateStaffRequest) (*CreateStaffResponse, error) {
+	return c.CreateStaffWithContext(context.Background(), req)
+}
+
+// CreateStaffWithContext is like CreateStaff but uses ctx for the underlying request.
+func (c *Client) CreateStaffWithContext(ctx context.Context, req *CreateStaffRequest) (*CreateStaffResponse, error) {
 	var response *CreateStaffResponse
 	emptyReq := &CreateStaffRequest{}
 	// checking if we don't have empty request
@@ -37,7 +51,7 @@ func (c *Client) CreateStaff(req *CreateStaffRequest) (*CreateStaffResponse, err
 	}
 	urlEndpoint := staffEndpoint
 	data, _ := json.Marshal(req)
-	resp, err := c.reamazeRequest(http.MethodPost, urlEndpoint, data)
+	resp, err := c.reamazeRequest(ctx, http.MethodPost, urlEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
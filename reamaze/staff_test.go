@@ -207,19 +207,19 @@ func Test_newStaffSettings(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    *ReamazeStaffOptions
+		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Testing newStaffSettings expansion when no arguments provided",
 			args:    args{},
-			want:    &ReamazeStaffOptions{},
+			want:    "",
 			wantErr: false,
 		},
 		{
 			name:    "Testing newSettings WithFilter Option ReamazeFilterAll",
 			args:    args{opts: []StaffOption{WithStaffPage(1)}},
-			want:    &ReamazeStaffOptions{ReamazeStaffPage: string("page=1")},
+			want:    "?page=1",
 			wantErr: false,
 		},
 	}
@@ -230,39 +230,34 @@ func Test_newStaffSettings(t *testing.T) {
 				t.Errorf("newStaffSettings() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("newStaffSettings() = %v, want %v", got, tt.want)
+			if err == nil && got.GetQuery() != tt.want {
+				t.Errorf("newStaffSettings() = %v, want %v", got.GetQuery(), tt.want)
 			}
 		})
 	}
 }
 
 func TestReamazeStaffOptions_GetQuery(t *testing.T) {
-	type fields struct {
-		ReamazeStaffPage string
-	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   string
+		name string
+		opts []StaffOption
+		want string
 	}{
 		{
-			name:   "Testing ReamazeStaffOptions_.GetQuery no fields set",
-			fields: fields{},
-			want:   "",
+			name: "Testing ReamazeStaffOptions.GetQuery no options set",
+			want: "",
 		},
 		{
-			name: "Testing ReamazeStaffOptions_.GetQuery with fields set",
-			fields: fields{
-				ReamazeStaffPage: "page=1",
-			},
-			want: "?page=1",
+			name: "Testing ReamazeStaffOptions.GetQuery with page set",
+			opts: []StaffOption{WithStaffPage(2)},
+			want: "?page=2",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := ReamazeStaffOptions{
-				ReamazeStaffPage: tt.fields.ReamazeStaffPage,
+			var r ReamazeStaffOptions
+			for _, opt := range tt.opts {
+				opt.Apply(&r)
 			}
 			if got := r.GetQuery(); got != tt.want {
 				t.Errorf("ReamazeStaffOptions.GetQuery() = %v, want %v", got, tt.want)
@@ -303,26 +298,26 @@ func TestReamazeStaffPage_Apply(t *testing.T) {
 		name string
 		w    ReamazeStaffPage
 		args args
-		want *ReamazeStaffOptions
+		want string
 	}{
 		{
 			name: "Testing if ReamazePage is not being set in ReamazeOptions if page 0",
 			w:    ReamazeStaffPage(0),
 			args: args{o: &ReamazeStaffOptions{}},
-			want: &ReamazeStaffOptions{},
+			want: "",
 		},
 		{
 			name: "Testing if ReamazePage is being set in ReamazeOptions",
 			w:    ReamazeStaffPage(2),
 			args: args{o: &ReamazeStaffOptions{}},
-			want: &ReamazeStaffOptions{ReamazeStaffPage: "page=2"},
+			want: "?page=2",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.w.Apply(tt.args.o)
-			got := tt.args.o
-			if !reflect.DeepEqual(got, tt.want) {
+			got := tt.args.o.GetQuery()
+			if got != tt.want {
 				t.Errorf("ReamazePage.Apply() = %v, want %v", got, tt.want)
 			}
 		})
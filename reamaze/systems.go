@@ -1,6 +1,7 @@
 package reamaze
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 )
@@ -8,9 +9,14 @@ import (
 // GetSystems will allow you to retrieve the systems for the Brand
 // https://www.reamaze.com/api/get_systems
 func (c *Client) GetSystems() (*GetSystemsResponse, error) {
+	return c.GetSystemsWithContext(context.Background())
+}
+
+// GetSystemsWithContext is like GetSystems but uses ctx for the underlying request.
+func (c *Client) GetSystemsWithContext(ctx context.Context) (*GetSystemsResponse, error) {
 	var response *GetSystemsResponse
 	urlEndpoint := systemsEndpoint
-	resp, err := c.reamazeRequest(http.MethodGet, urlEndpoint, []byte{})
+	resp, err := c.reamazeRequest(ctx, http.MethodGet, urlEndpoint, []byte{})
 	if err != nil {
 		return nil, err
 	}
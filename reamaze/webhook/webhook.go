@@ -0,0 +1,199 @@
+// Package webhook receives re:amaze webhooks, verifies their signature and dispatches them to registered handler funcs.
+//
+//	handler := webhook.NewHandler(secret)
+//	handler.OnConversationCreated(func(ctx context.Context, conversation *reamaze.GetConversationResponse) error {
+//		log.Println("new conversation", conversation.Slug)
+//		return nil
+//	})
+//	http.Handle("/reamaze/webhook", handler)
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+const (
+	// SignatureHeader holds HMAC SHA256 of the request body computed with the webhook secret
+	SignatureHeader = "X-Reamaze-Hmac-SHA256"
+	// TopicHeader holds the event topic e.g. conversation.created
+	TopicHeader = "X-Reamaze-Topic"
+	// maxBodySize limits the size of the webhook payload we are willing to read
+	maxBodySize = 5 << 20
+)
+
+// Topic is the webhook event type sent in TopicHeader
+type Topic string
+
+const (
+	TopicConversationCreated Topic = "conversation.created"
+	TopicConversationUpdated Topic = "conversation.updated"
+	TopicMessageCreated      Topic = "message.created"
+	TopicContactCreated      Topic = "contact.created"
+	TopicContactUpdated      Topic = "contact.updated"
+	TopicIncidentCreated     Topic = "incident.created"
+	TopicIncidentUpdated     Topic = "incident.updated"
+)
+
+// Errors reported to re:amaze when the webhook is rejected
+var (
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrMissingTopic     = errors.New("webhook: missing topic")
+)
+
+// Handler is http.Handler receiving re:amaze webhooks
+type Handler struct {
+	secret   []byte
+	mu       sync.RWMutex
+	handlers map[Topic]func(ctx context.Context, body []byte) error
+	// ErrorLog is called with errors returned by handler funcs, optional
+	ErrorLog func(topic Topic, err error)
+}
+
+// NewHandler creates Handler verifying webhooks with the given shared secret
+func NewHandler(secret string) *Handler {
+	return &Handler{
+		secret:   []byte(secret),
+		handlers: make(map[Topic]func(ctx context.Context, body []byte) error),
+	}
+}
+
+// register decodes the payload into T before calling fn
+func register[T any](h *Handler, topic Topic, fn func(ctx context.Context, event *T) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[topic] = func(ctx context.Context, body []byte) error {
+		var event T
+		if err := json.Unmarshal(body, &event); err != nil {
+			return &decodeError{err: err}
+		}
+		return fn(ctx, &event)
+	}
+}
+
+// OnConversationCreated registers fn called for TopicConversationCreated webhooks
+func (h *Handler) OnConversationCreated(fn func(ctx context.Context, conversation *reamaze.GetConversationResponse) error) {
+	register(h, TopicConversationCreated, fn)
+}
+
+// OnConversationUpdated registers fn called for TopicConversationUpdated webhooks
+func (h *Handler) OnConversationUpdated(fn func(ctx context.Context, conversation *reamaze.GetConversationResponse) error) {
+	register(h, TopicConversationUpdated, fn)
+}
+
+// OnMessageCreated registers fn called for TopicMessageCreated webhooks
+func (h *Handler) OnMessageCreated(fn func(ctx context.Context, message *reamaze.Message) error) {
+	register(h, TopicMessageCreated, fn)
+}
+
+// OnContactCreated registers fn called for TopicContactCreated webhooks
+func (h *Handler) OnContactCreated(fn func(ctx context.Context, contact *reamaze.GetContactResponse) error) {
+	register(h, TopicContactCreated, fn)
+}
+
+// OnContactUpdated registers fn called for TopicContactUpdated webhooks
+func (h *Handler) OnContactUpdated(fn func(ctx context.Context, contact *reamaze.GetContactResponse) error) {
+	register(h, TopicContactUpdated, fn)
+}
+
+// OnIncidentCreated registers fn called for TopicIncidentCreated webhooks
+func (h *Handler) OnIncidentCreated(fn func(ctx context.Context, incident *reamaze.GetIncidentResponse) error) {
+	register(h, TopicIncidentCreated, fn)
+}
+
+// OnIncidentUpdated registers fn called for TopicIncidentUpdated webhooks
+func (h *Handler) OnIncidentUpdated(fn func(ctx context.Context, incident *reamaze.GetIncidentResponse) error) {
+	register(h, TopicIncidentUpdated, fn)
+}
+
+// ServeHTTP verifies the signature and dispatches the webhook to the handler func registered for its topic.
+// Webhooks with topics without registered handler func are acknowledged and ignored.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "cannot read request body", http.StatusBadRequest)
+		return
+	}
+	if !VerifySignature(h.secret, body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, ErrInvalidSignature.Error(), http.StatusUnauthorized)
+		return
+	}
+	topic := Topic(strings.TrimSpace(r.Header.Get(TopicHeader)))
+	if len(topic) == 0 {
+		http.Error(w, ErrMissingTopic.Error(), http.StatusBadRequest)
+		return
+	}
+	h.mu.RLock()
+	fn, ok := h.handlers[topic]
+	h.mu.RUnlock()
+	if !ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err := fn(r.Context(), body); err != nil {
+		if h.ErrorLog != nil {
+			h.ErrorLog(topic, err)
+		}
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			http.Error(w, decodeErr.Error(), http.StatusBadRequest)
+			return
+		}
+		// re:amaze will retry the webhook when we respond with an error
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// VerifySignature checks if signature is HMAC SHA256 of body computed with secret, signature can be base64 or hex encoded
+func VerifySignature(secret []byte, body []byte, signature string) bool {
+	if len(secret) == 0 || len(signature) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	if decoded, err := base64.StdEncoding.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return true
+	}
+	if decoded, err := hex.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return true
+	}
+	return false
+}
+
+// Sign returns base64 encoded HMAC SHA256 of body, useful when testing webhook handlers
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return "webhook: cannot decode payload: " + e.err.Error()
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
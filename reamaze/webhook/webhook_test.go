@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/meant4/reamaze-go/reamaze"
+)
+
+func newRequest(method string, topic Topic, body string, signature string) *http.Request {
+	req := httptest.NewRequest(method, "/webhook", strings.NewReader(body))
+	if len(topic) > 0 {
+		req.Header.Set(TopicHeader, string(topic))
+	}
+	if len(signature) > 0 {
+		req.Header.Set(SignatureHeader, signature)
+	}
+	return req
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"slug":"dummy"}`)
+	tests := []struct {
+		name      string
+		secret    []byte
+		signature string
+		want      bool
+	}{
+		{name: "Testing base64 signature", secret: secret, signature: Sign(secret, body), want: true},
+		{name: "Testing hex signature", secret: secret, signature: func() string {
+			return hex.EncodeToString([]byte(mustDecodeBase64(t, Sign(secret, body))))
+		}(), want: true},
+		{name: "Testing wrong secret", secret: []byte("other"), signature: Sign(secret, body), want: false},
+		{name: "Testing empty signature", secret: secret, signature: "", want: false},
+		{name: "Testing empty secret", secret: nil, signature: Sign(nil, body), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, body, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	secret := "secret"
+	var gotConversation *reamaze.GetConversationResponse
+	var gotMessage *reamaze.Message
+	var gotContact *reamaze.GetContactResponse
+	var gotIncident *reamaze.GetIncidentResponse
+	h := NewHandler(secret)
+	h.OnConversationCreated(func(ctx context.Context, conversation *reamaze.GetConversationResponse) error {
+		gotConversation = conversation
+		return nil
+	})
+	h.OnConversationUpdated(func(ctx context.Context, conversation *reamaze.GetConversationResponse) error {
+		return errors.New("dummy")
+	})
+	h.OnMessageCreated(func(ctx context.Context, message *reamaze.Message) error {
+		gotMessage = message
+		return nil
+	})
+	h.OnContactCreated(func(ctx context.Context, contact *reamaze.GetContactResponse) error {
+		gotContact = contact
+		return nil
+	})
+	h.OnIncidentUpdated(func(ctx context.Context, incident *reamaze.GetIncidentResponse) error {
+		gotIncident = incident
+		return nil
+	})
+	var loggedTopic Topic
+	h.ErrorLog = func(topic Topic, err error) {
+		loggedTopic = topic
+	}
+
+	sign := func(body string) string {
+		return Sign([]byte(secret), []byte(body))
+	}
+	tests := []struct {
+		name string
+		req  *http.Request
+		want int
+	}{
+		{name: "Testing GET request", req: newRequest(http.MethodGet, TopicConversationCreated, "", ""), want: http.StatusMethodNotAllowed},
+		{name: "Testing invalid signature", req: newRequest(http.MethodPost, TopicConversationCreated, `{"slug":"dummy"}`, sign(`{"slug":"other"}`)), want: http.StatusUnauthorized},
+		{name: "Testing missing topic", req: newRequest(http.MethodPost, "", `{"slug":"dummy"}`, sign(`{"slug":"dummy"}`)), want: http.StatusBadRequest},
+		{name: "Testing invalid JSON", req: newRequest(http.MethodPost, TopicConversationCreated, `{"slug":`, sign(`{"slug":`)), want: http.StatusBadRequest},
+		{name: "Testing unregistered topic", req: newRequest(http.MethodPost, TopicContactUpdated, `{}`, sign(`{}`)), want: http.StatusOK},
+		{name: "Testing handler error", req: newRequest(http.MethodPost, TopicConversationUpdated, `{}`, sign(`{}`)), want: http.StatusInternalServerError},
+		{name: "Testing conversation created", req: newRequest(http.MethodPost, TopicConversationCreated, `{"slug":"dummy"}`, sign(`{"slug":"dummy"}`)), want: http.StatusOK},
+		{name: "Testing message created", req: newRequest(http.MethodPost, TopicMessageCreated, `{"body":"hello"}`, sign(`{"body":"hello"}`)), want: http.StatusOK},
+		{name: "Testing contact created", req: newRequest(http.MethodPost, TopicContactCreated, `{"email":"test@example.com"}`, sign(`{"email":"test@example.com"}`)), want: http.StatusOK},
+		{name: "Testing incident updated", req: newRequest(http.MethodPost, TopicIncidentUpdated, `{"id":"dummy"}`, sign(`{"id":"dummy"}`)), want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tt.req)
+			if rec.Code != tt.want {
+				t.Errorf("Handler.ServeHTTP() status = %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+	if gotConversation == nil || gotConversation.Slug != "dummy" {
+		t.Errorf("OnConversationCreated() got %v", gotConversation)
+	}
+	if gotMessage == nil || gotMessage.Body != "hello" {
+		t.Errorf("OnMessageCreated() got %v", gotMessage)
+	}
+	if gotContact == nil || gotContact.Email != "test@example.com" {
+		t.Errorf("OnContactCreated() got %v", gotContact)
+	}
+	if gotIncident == nil || gotIncident.ID != "dummy" {
+		t.Errorf("OnIncidentUpdated() got %v", gotIncident)
+	}
+	if loggedTopic != TopicConversationUpdated {
+		t.Errorf("ErrorLog topic = %v, want %v", loggedTopic, TopicConversationUpdated)
+	}
+}
+
+func mustDecodeBase64(t *testing.T, s string) string {
+	t.Helper()
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatalf("base64 decode error = %v", err)
+	}
+	return string(decoded)
+}